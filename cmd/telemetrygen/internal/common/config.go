@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	defaultGRPCEndpoint = "localhost:4317"
+	defaultHTTPEndpoint = "localhost:4318"
+)
+
+var (
+	errFormatOTLPAttributes       = fmt.Errorf("value should be of the format key=\"value\"")
+	errDoubleQuotesOTLPAttributes = fmt.Errorf("value should be a string wrapped in double quotes")
+)
+
+// KeyValue is a flag value collecting key="value" pairs.
+type KeyValue map[string]string
+
+var _ pflag.Value = (*KeyValue)(nil)
+
+func (v *KeyValue) String() string {
+	return ""
+}
+
+func (v *KeyValue) Set(s string) error {
+	kv := strings.SplitN(s, "=", 2)
+	if len(kv) != 2 {
+		return errFormatOTLPAttributes
+	}
+	val := kv[1]
+	if len(val) < 2 || !strings.HasPrefix(val, "\"") || !strings.HasSuffix(val, "\"") {
+		return errDoubleQuotesOTLPAttributes
+	}
+
+	(*v)[kv[0]] = val[1 : len(val)-1]
+	return nil
+}
+
+func (v *KeyValue) Type() string {
+	return "map[string]string"
+}
+
+// Config describes the test scenario shared by all signals.
+type Config struct {
+	WorkerCount   int
+	Rate          int64
+	TotalDuration time.Duration
+	ServiceName   string
+
+	// OTLP config
+	Endpoint           string
+	Insecure           bool
+	InsecureSkipVerify bool
+	CaFile             string
+	UseHTTP            bool
+	Headers            KeyValue
+	ResourceAttributes KeyValue
+
+	// TelemetryAttributes are added to every span, data point or log record.
+	TelemetryAttributes KeyValue
+}
+
+// CommonFlags registers the flags shared by all signals.
+func (c *Config) CommonFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&c.WorkerCount, "workers", 1, "Number of workers (goroutines) to run")
+	fs.Int64Var(&c.Rate, "rate", 0, "Approximately how many items per second each worker should generate. Zero means no throttling.")
+	fs.DurationVar(&c.TotalDuration, "duration", 0, "For how long to run the test")
+	fs.StringVar(&c.ServiceName, "service", "telemetrygen", "Service name to use")
+
+	fs.StringVar(&c.Endpoint, "otlp-endpoint", "", "Destination endpoint for exporting telemetry, defaults to "+
+		defaultGRPCEndpoint+" for gRPC and "+defaultHTTPEndpoint+" for HTTP")
+	fs.BoolVar(&c.Insecure, "otlp-insecure", false, "Whether to disable client transport security for the exporter's gRPC or HTTP connection")
+	fs.BoolVar(&c.InsecureSkipVerify, "otlp-insecure-skip-verify", false, "Whether to skip the verification of the server's certificate chain and host name")
+	fs.StringVar(&c.CaFile, "ca-cert", "", "Trusted certificate authority file used to verify the server's certificate")
+	fs.BoolVar(&c.UseHTTP, "otlp-http", false, "Whether to use HTTP exporter rather than a gRPC one")
+
+	// custom headers
+	c.Headers = make(map[string]string)
+	fs.Var(&c.Headers, "otlp-header", "Custom header to be passed along with each OTLP request. The value is expected in the format key=\"value\". "+
+		"Flag may be repeated to set multiple headers (e.g --otlp-header key1=\"value1\" --otlp-header key2=\"value2\")")
+
+	// custom resource attributes
+	c.ResourceAttributes = make(map[string]string)
+	fs.Var(&c.ResourceAttributes, "otlp-attributes", "Custom resource attributes to use. The value is expected in the format key=\"value\". "+
+		"Flag may be repeated to set multiple attributes (e.g --otlp-attributes key1=\"value1\" --otlp-attributes key2=\"value2\")")
+
+	c.TelemetryAttributes = make(map[string]string)
+	fs.Var(&c.TelemetryAttributes, "telemetry-attributes", "Custom attributes added to every span, data point or log record. The value is expected in the format key=\"value\". "+
+		"Flag may be repeated to set multiple attributes (e.g --telemetry-attributes key1=\"value1\" --telemetry-attributes key2=\"value2\")")
+}
+
+// Validate checks the flags shared by all signals.
+func (c *Config) Validate() error {
+	if c.WorkerCount <= 0 {
+		return errors.New("`workers` must be greater than 0")
+	}
+	if c.Rate < 0 {
+		return errors.New("`rate` must not be negative")
+	}
+	if c.TotalDuration < 0 {
+		return errors.New("`duration` must not be negative")
+	}
+	return nil
+}
+
+// EndpointOrDefault returns the configured endpoint, or the default one of the selected protocol.
+func (c *Config) EndpointOrDefault() string {
+	if c.Endpoint != "" {
+		return c.Endpoint
+	}
+	if c.UseHTTP {
+		return defaultHTTPEndpoint
+	}
+	return defaultGRPCEndpoint
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	tracesPath  = "/v1/traces"
+	metricsPath = "/v1/metrics"
+	logsPath    = "/v1/logs"
+)
+
+// Exporter sends telemetry to an OTLP endpoint over gRPC or HTTP.
+type Exporter interface {
+	ExportTraces(ctx context.Context, td ptrace.Traces) error
+	ExportMetrics(ctx context.Context, md pmetric.Metrics) error
+	ExportLogs(ctx context.Context, ld plog.Logs) error
+	Shutdown() error
+}
+
+// NewExporter creates an Exporter for the protocol, endpoint and TLS settings of the config.
+func NewExporter(cfg *Config) (Exporter, error) {
+	var tlsCfg *tls.Config
+	if !cfg.Insecure {
+		var err error
+		if tlsCfg, err = newTLSConfig(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.UseHTTP {
+		scheme := "https"
+		if cfg.Insecure {
+			scheme = "http"
+		}
+		return &httpExporter{
+			client:  &http.Client{Transport: &http.Transport{TLSClientConfig: tlsCfg}},
+			baseURL: scheme + "://" + cfg.EndpointOrDefault(),
+			headers: cfg.Headers,
+		}, nil
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsCfg != nil {
+		creds = credentials.NewTLS(tlsCfg)
+	}
+	conn, err := grpc.Dial(cfg.EndpointOrDefault(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &grpcExporter{
+		conn:          conn,
+		tracesClient:  ptraceotlp.NewClient(conn),
+		metricsClient: pmetricotlp.NewClient(conn),
+		logsClient:    plogotlp.NewClient(conn),
+		md:            metadata.New(cfg.Headers),
+	}, nil
+}
+
+func newTLSConfig(cfg *Config) (*tls.Config, error) {
+	tlsCfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // #nosec G402
+	}
+	if cfg.CaFile != "" {
+		pem, err := ioutil.ReadFile(cfg.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to parse CA file %q", cfg.CaFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	return tlsCfg, nil
+}
+
+type grpcExporter struct {
+	conn          *grpc.ClientConn
+	tracesClient  ptraceotlp.Client
+	metricsClient pmetricotlp.Client
+	logsClient    plogotlp.Client
+	md            metadata.MD
+}
+
+func (e *grpcExporter) ExportTraces(ctx context.Context, td ptrace.Traces) error {
+	_, err := e.tracesClient.Export(metadata.NewOutgoingContext(ctx, e.md), ptraceotlp.NewRequestFromTraces(td))
+	return err
+}
+
+func (e *grpcExporter) ExportMetrics(ctx context.Context, md pmetric.Metrics) error {
+	_, err := e.metricsClient.Export(metadata.NewOutgoingContext(ctx, e.md), pmetricotlp.NewRequestFromMetrics(md))
+	return err
+}
+
+func (e *grpcExporter) ExportLogs(ctx context.Context, ld plog.Logs) error {
+	_, err := e.logsClient.Export(metadata.NewOutgoingContext(ctx, e.md), plogotlp.NewRequestFromLogs(ld))
+	return err
+}
+
+func (e *grpcExporter) Shutdown() error {
+	return e.conn.Close()
+}
+
+type httpExporter struct {
+	client  *http.Client
+	baseURL string
+	headers map[string]string
+}
+
+func (e *httpExporter) ExportTraces(ctx context.Context, td ptrace.Traces) error {
+	body, err := ptraceotlp.NewRequestFromTraces(td).MarshalProto()
+	if err != nil {
+		return err
+	}
+	return e.export(ctx, tracesPath, body)
+}
+
+func (e *httpExporter) ExportMetrics(ctx context.Context, md pmetric.Metrics) error {
+	body, err := pmetricotlp.NewRequestFromMetrics(md).MarshalProto()
+	if err != nil {
+		return err
+	}
+	return e.export(ctx, metricsPath, body)
+}
+
+func (e *httpExporter) ExportLogs(ctx context.Context, ld plog.Logs) error {
+	body, err := plogotlp.NewRequestFromLogs(ld).MarshalProto()
+	if err != nil {
+		return err
+	}
+	return e.export(ctx, logsPath, body)
+}
+
+func (e *httpExporter) export(ctx context.Context, path string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to export to %s: %s", req.URL, resp.Status)
+	}
+	return nil
+}
+
+func (e *httpExporter) Shutdown() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
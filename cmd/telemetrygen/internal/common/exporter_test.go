@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockTracesServer struct {
+	requests []ptraceotlp.Request
+	md       metadata.MD
+}
+
+func (s *mockTracesServer) Export(ctx context.Context, req ptraceotlp.Request) (ptraceotlp.Response, error) {
+	s.requests = append(s.requests, req)
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	return ptraceotlp.NewResponse(), nil
+}
+
+func TestGRPCExporter(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	mock := &mockTracesServer{}
+	ptraceotlp.RegisterServer(srv, mock)
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	defer srv.Stop()
+
+	exp, err := NewExporter(&Config{
+		Endpoint: ln.Addr().String(),
+		Insecure: true,
+		Headers:  map[string]string{"x-tenant": "acme"},
+	})
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	require.NoError(t, exp.ExportTraces(context.Background(), td))
+	require.NoError(t, exp.Shutdown())
+
+	require.Len(t, mock.requests, 1)
+	assert.Equal(t, td, mock.requests[0].Traces())
+	assert.Equal(t, []string{"acme"}, mock.md.Get("x-tenant"))
+}
+
+func TestHTTPExporter(t *testing.T) {
+	bodies := map[string][]byte{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		assert.Equal(t, "acme", r.Header.Get("x-tenant"))
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies[r.URL.Path] = body
+	}))
+	defer srv.Close()
+
+	exp, err := NewExporter(&Config{
+		Endpoint: strings.TrimPrefix(srv.URL, "http://"),
+		Insecure: true,
+		UseHTTP:  true,
+		Headers:  map[string]string{"x-tenant": "acme"},
+	})
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	require.NoError(t, exp.ExportTraces(context.Background(), td))
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	require.NoError(t, exp.ExportMetrics(context.Background(), md))
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("log")
+	require.NoError(t, exp.ExportLogs(context.Background(), ld))
+	require.NoError(t, exp.Shutdown())
+
+	tr := ptraceotlp.NewRequest()
+	require.NoError(t, tr.UnmarshalProto(bodies["/v1/traces"]))
+	assert.Equal(t, td, tr.Traces())
+	mr := pmetricotlp.NewRequest()
+	require.NoError(t, mr.UnmarshalProto(bodies["/v1/metrics"]))
+	assert.Equal(t, md, mr.Metrics())
+	lr := plogotlp.NewRequest()
+	require.NoError(t, lr.UnmarshalProto(bodies["/v1/logs"]))
+	assert.Equal(t, ld, lr.Logs())
+}
+
+func TestHTTPExporterError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	exp, err := NewExporter(&Config{
+		Endpoint: strings.TrimPrefix(srv.URL, "http://"),
+		Insecure: true,
+		UseHTTP:  true,
+	})
+	require.NoError(t, err)
+	err = exp.ExportLogs(context.Background(), plog.NewLogs())
+	assert.EqualError(t, err, "failed to export to "+srv.URL+"/v1/logs: 503 Service Unavailable")
+}
+
+func TestNewExporterInvalidCAFile(t *testing.T) {
+	_, err := NewExporter(&Config{CaFile: "/does/not/exist"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// Worker holds the state shared by the workers generating a signal.
+type Worker struct {
+	Running        *atomic.Bool  // pointer to shared flag that indicates it's time to stop the test
+	NumItems       int           // how many items the worker has to generate (only when duration==0)
+	TotalDuration  time.Duration // how long to run the test for (overrides `NumItems`)
+	LimitPerSecond rate.Limit    // how many items per second to generate
+	Index          int           // index of the worker, useful to generate distinct data per worker
+	Logger         *zap.Logger
+}
+
+// Done reports whether the worker generated all of its items after generating the i-th one.
+func (w Worker) Done(i int) bool {
+	return !w.Running.Load() || (w.NumItems != 0 && i >= w.NumItems)
+}
+
+// Run starts cfg.WorkerCount workers executing simulate and waits for them to finish.
+// The workers run for the configured duration, or until each one generated numItems items.
+func Run(cfg *Config, numItems int, logger *zap.Logger, simulate func(w Worker)) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if cfg.TotalDuration > 0 {
+		numItems = 0
+	} else if numItems <= 0 {
+		return fmt.Errorf("either the number of items or `duration` must be greater than 0")
+	}
+
+	limit := rate.Limit(cfg.Rate)
+	if cfg.Rate == 0 {
+		limit = rate.Inf
+		logger.Info("generation isn't being throttled")
+	} else {
+		logger.Info("generation is limited", zap.Float64("per-second", float64(limit)))
+	}
+
+	wg := sync.WaitGroup{}
+	running := atomic.NewBool(true)
+
+	for i := 0; i < cfg.WorkerCount; i++ {
+		wg.Add(1)
+		w := Worker{
+			Running:        running,
+			NumItems:       numItems,
+			TotalDuration:  cfg.TotalDuration,
+			LimitPerSecond: limit,
+			Index:          i,
+			Logger:         logger.With(zap.Int("worker", i)),
+		}
+		go func() {
+			defer wg.Done()
+			simulate(w)
+		}()
+	}
+	if cfg.TotalDuration > 0 {
+		time.Sleep(cfg.TotalDuration)
+		running.Store(false)
+	}
+	wg.Wait()
+	return nil
+}
+
+// Resource builds the resource shared by all generated telemetry.
+func Resource(cfg *Config) pcommon.Resource {
+	res := pcommon.NewResource()
+	// may be overridden by `--otlp-attributes service.name="foo"`
+	res.Attributes().UpsertString("service.name", cfg.ServiceName)
+	for k, v := range cfg.ResourceAttributes {
+		res.Attributes().UpsertString(k, v)
+	}
+	return res
+}
+
+// InsertTelemetryAttributes adds the configured telemetry attributes to attrs.
+func InsertTelemetryAttributes(cfg *Config, attrs pcommon.Map) {
+	for k, v := range cfg.TelemetryAttributes {
+		attrs.UpsertString(k, v)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/logs"
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+// Start generates logs according to the config and sends them to the configured endpoint.
+func Start(cfg *Config, logger *zap.Logger) error {
+	exp, err := common.NewExporter(&cfg.Config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		logger.Info("stopping the exporter")
+		if err := exp.Shutdown(); err != nil {
+			logger.Error("failed to stop the exporter", zap.Error(err))
+		}
+	}()
+	return Run(cfg, exp, logger)
+}
+
+// Run executes the test scenario, sending the logs to exp.
+func Run(cfg *Config, exp common.Exporter, logger *zap.Logger) error {
+	return common.Run(&cfg.Config, cfg.NumLogs, logger, func(w common.Worker) {
+		worker{Worker: w, cfg: cfg, exporter: exp}.simulateLogs()
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/logs"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+type worker struct {
+	common.Worker
+	cfg      *Config
+	exporter common.Exporter
+}
+
+func (w worker) simulateLogs() {
+	limiter := rate.NewLimiter(w.LimitPerSecond, 1)
+	var i int
+	for !w.Done(i) {
+		if err := limiter.Wait(context.Background()); err != nil {
+			w.Logger.Fatal("limiter wait failed, retry", zap.Error(err))
+		}
+		if err := w.exporter.ExportLogs(context.Background(), w.newLogs(time.Now())); err != nil {
+			w.Logger.Error("failed to export logs", zap.Error(err))
+		}
+		i++
+	}
+	w.Logger.Info("logs generated", zap.Int("logs", i))
+}
+
+func (w worker) newLogs(now time.Time) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	common.Resource(&w.cfg.Config).CopyTo(rl.Resource())
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("telemetrygen")
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+	lr.SetSeverityText(w.cfg.SeverityText)
+	lr.SetSeverityNumber(plog.SeverityNumber(w.cfg.SeverityNumber))
+	lr.Body().SetStringVal(w.cfg.Body)
+	lr.Attributes().UpsertInt("worker", int64(w.Index))
+	common.InsertTelemetryAttributes(&w.cfg.Config, lr.Attributes())
+	return ld
+}
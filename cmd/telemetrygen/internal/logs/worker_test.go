@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+type mockExporter struct {
+	mu   sync.Mutex
+	logs []plog.Logs
+}
+
+func (m *mockExporter) ExportLogs(_ context.Context, ld plog.Logs) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logs = append(m.logs, ld)
+	return nil
+}
+
+func (m *mockExporter) ExportTraces(context.Context, ptrace.Traces) error    { return nil }
+func (m *mockExporter) ExportMetrics(context.Context, pmetric.Metrics) error { return nil }
+func (m *mockExporter) Shutdown() error                                      { return nil }
+
+func TestFixedNumberOfLogs(t *testing.T) {
+	// prepare
+	exp := &mockExporter{}
+	cfg := &Config{
+		Config: common.Config{
+			WorkerCount: 2,
+			ServiceName: "svc",
+		},
+		NumLogs:        2,
+		Body:           "hello",
+		SeverityText:   "Error",
+		SeverityNumber: 17,
+	}
+
+	// test
+	require.NoError(t, Run(cfg, exp, zap.NewNop()))
+
+	// verify
+	require.Len(t, exp.logs, 4)
+	lr := exp.logs[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "hello", lr.Body().StringVal())
+	assert.Equal(t, "Error", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberERROR, lr.SeverityNumber())
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/metrics"
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+const (
+	metricTypeGauge = "Gauge"
+	metricTypeSum   = "Sum"
+)
+
+// Config describes the test scenario for metrics.
+type Config struct {
+	common.Config
+	NumMetrics int
+	MetricName string
+	MetricType string
+}
+
+// Flags registers config flags.
+func (c *Config) Flags(fs *pflag.FlagSet) {
+	c.CommonFlags(fs)
+	fs.IntVar(&c.NumMetrics, "metrics", 1, "Number of metrics to generate in each worker (ignored if duration is provided)")
+	fs.StringVar(&c.MetricName, "metric-name", "gen", "Name of the generated metric")
+	fs.StringVar(&c.MetricType, "metric-type", metricTypeGauge, "Type of the generated metric, one of "+metricTypeGauge+" or "+metricTypeSum)
+}
+
+// Validate checks the config.
+func (c *Config) Validate() error {
+	if c.MetricType != metricTypeGauge && c.MetricType != metricTypeSum {
+		return fmt.Errorf("unsupported metric type %q, must be one of %q or %q", c.MetricType, metricTypeGauge, metricTypeSum)
+	}
+	return c.Config.Validate()
+}
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/metrics"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+type worker struct {
+	common.Worker
+	cfg      *Config
+	exporter common.Exporter
+}
+
+func (w worker) simulateMetrics() {
+	limiter := rate.NewLimiter(w.LimitPerSecond, 1)
+	start := time.Now()
+	var i int
+	for !w.Done(i) {
+		if err := limiter.Wait(context.Background()); err != nil {
+			w.Logger.Fatal("limiter wait failed, retry", zap.Error(err))
+		}
+		if err := w.exporter.ExportMetrics(context.Background(), w.newMetrics(start, time.Now(), int64(i))); err != nil {
+			w.Logger.Error("failed to export metrics", zap.Error(err))
+		}
+		i++
+	}
+	w.Logger.Info("metrics generated", zap.Int("metrics", i))
+}
+
+// newMetrics builds a single metric with one data point holding the given value.
+// Sums are cumulative since the worker started.
+func (w worker) newMetrics(start, now time.Time, value int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	common.Resource(&w.cfg.Config).CopyTo(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("telemetrygen")
+
+	metric := sm.Metrics().AppendEmpty()
+	metric.SetName(w.cfg.MetricName)
+
+	var dp pmetric.NumberDataPoint
+	switch w.cfg.MetricType {
+	case metricTypeSum:
+		metric.SetDataType(pmetric.MetricDataTypeSum)
+		metric.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+		metric.Sum().SetIsMonotonic(true)
+		dp = metric.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	default:
+		metric.SetDataType(pmetric.MetricDataTypeGauge)
+		dp = metric.Gauge().DataPoints().AppendEmpty()
+	}
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	dp.SetIntVal(value)
+	dp.Attributes().UpsertInt("worker", int64(w.Index))
+	common.InsertTelemetryAttributes(&w.cfg.Config, dp.Attributes())
+	return md
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+type mockExporter struct {
+	mu      sync.Mutex
+	metrics []pmetric.Metrics
+}
+
+func (m *mockExporter) ExportMetrics(_ context.Context, md pmetric.Metrics) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metrics = append(m.metrics, md)
+	return nil
+}
+
+func (m *mockExporter) ExportTraces(context.Context, ptrace.Traces) error { return nil }
+func (m *mockExporter) ExportLogs(context.Context, plog.Logs) error       { return nil }
+func (m *mockExporter) Shutdown() error                                   { return nil }
+
+func TestFixedNumberOfMetrics(t *testing.T) {
+	tests := []struct {
+		metricType string
+		dataType   pmetric.MetricDataType
+	}{
+		{metricType: metricTypeGauge, dataType: pmetric.MetricDataTypeGauge},
+		{metricType: metricTypeSum, dataType: pmetric.MetricDataTypeSum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.metricType, func(t *testing.T) {
+			exp := &mockExporter{}
+			cfg := &Config{
+				Config: common.Config{
+					WorkerCount:         1,
+					TelemetryAttributes: map[string]string{"key": "value"},
+				},
+				NumMetrics: 3,
+				MetricName: "gen",
+				MetricType: tt.metricType,
+			}
+
+			require.NoError(t, Run(cfg, exp, zap.NewNop()))
+
+			require.Len(t, exp.metrics, 3)
+			for i, md := range exp.metrics {
+				metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+				assert.Equal(t, "gen", metric.Name())
+				assert.Equal(t, tt.dataType, metric.DataType())
+				var dp pmetric.NumberDataPoint
+				if tt.dataType == pmetric.MetricDataTypeSum {
+					dp = metric.Sum().DataPoints().At(0)
+				} else {
+					dp = metric.Gauge().DataPoints().At(0)
+				}
+				assert.Equal(t, int64(i), dp.IntVal())
+				v, _ := dp.Attributes().Get("key")
+				assert.Equal(t, "value", v.StringVal())
+			}
+		})
+	}
+}
+
+func TestInvalidMetricType(t *testing.T) {
+	cfg := &Config{
+		Config:     common.Config{WorkerCount: 1},
+		NumMetrics: 1,
+		MetricType: "Histogram",
+	}
+	assert.EqualError(t, Run(cfg, &mockExporter{}, zap.NewNop()), `unsupported metric type "Histogram", must be one of "Gauge" or "Sum"`)
+}
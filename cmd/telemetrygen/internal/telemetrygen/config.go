@@ -13,58 +13,93 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package telemetrygen // import "github.com/open-telemetry/opentelemetry-collector-contrib/telemetrygen/internal/telemetrygen"
+package telemetrygen // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/telemetrygen"
 
 import (
-	"errors"
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/logs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/traces"
+)
+
+var (
+	tracesCfg  = new(traces.Config)
+	metricsCfg = new(metrics.Config)
+	logsCfg    = new(logs.Config)
 )
 
-// rootCmd is the root command on which will be run children commands
 var rootCmd = &cobra.Command{
 	Use:     "telemetrygen",
-	Short:   "Telemetrygen simulates a client generating traces and metrics",
-	Example: "telemetrygen metrics\ntelemetrygen traces",
+	Short:   "Telemetrygen simulates a client generating traces, metrics and logs",
+	Example: "telemetrygen metrics\ntelemetrygen traces\ntelemetrygen logs",
 }
 
-// tracesCmd is the command responsible for sending traces
 var tracesCmd = &cobra.Command{
 	Use:     "traces",
 	Short:   "Simulates a client generating traces",
 	Example: "telemetrygen traces",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		err := errors.New("[WIP] The 'traces' command is a work in progress, come back later")
-		return err
+		logger, err := newLogger()
+		if err != nil {
+			return err
+		}
+		return traces.Start(tracesCfg, logger)
 	},
 }
 
-// metricsCmd is the command responsible for sending metrics
 var metricsCmd = &cobra.Command{
 	Use:     "metrics",
 	Short:   "Simulates a client generating metrics",
 	Example: "telemetrygen metrics",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		err := errors.New("[WIP] The 'metrics' command is a work in progress, come back later")
-		return err
+		logger, err := newLogger()
+		if err != nil {
+			return err
+		}
+		return metrics.Start(metricsCfg, logger)
+	},
+}
+
+var logsCmd = &cobra.Command{
+	Use:     "logs",
+	Short:   "Simulates a client generating logs",
+	Example: "telemetrygen logs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger, err := newLogger()
+		if err != nil {
+			return err
+		}
+		return logs.Start(logsCfg, logger)
 	},
 }
 
 func init() {
-	rootCmd.AddCommand(tracesCmd, metricsCmd)
+	rootCmd.AddCommand(tracesCmd, metricsCmd, logsCmd)
+
+	tracesCfg.Flags(tracesCmd.Flags())
+	metricsCfg.Flags(metricsCmd.Flags())
+	logsCfg.Flags(logsCmd.Flags())
 
 	// Disabling completion command for end user
 	// https://github.com/spf13/cobra/blob/master/shell_completions.md
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
+}
 
+func newLogger() (*zap.Logger, error) {
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain logger: %w", err)
+	}
+	return logger, nil
 }
 
-// Execute tries to run the input command
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
-		// TODO: Uncomment the line below when using Run instead of RunE in the xxxCmd functions
-		// fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
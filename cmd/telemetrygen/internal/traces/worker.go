@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/traces"
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+const fakeIP string = "1.2.3.4"
+
+type worker struct {
+	common.Worker
+	cfg      *Config
+	exporter common.Exporter
+}
+
+func (w worker) simulateTraces() {
+	limiter := rate.NewLimiter(w.LimitPerSecond, 1)
+	var i int
+	for !w.Done(i) {
+		if err := limiter.Wait(context.Background()); err != nil {
+			w.Logger.Fatal("limiter wait failed, retry", zap.Error(err))
+		}
+		if err := w.exporter.ExportTraces(context.Background(), w.newTrace(time.Now())); err != nil {
+			w.Logger.Error("failed to export traces", zap.Error(err))
+		}
+		i++
+	}
+	w.Logger.Info("traces generated", zap.Int("traces", i))
+}
+
+// newTrace builds a trace made of a client span and its server child spans.
+func (w worker) newTrace(now time.Time) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	common.Resource(&w.cfg.Config).CopyTo(rs.Resource())
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("telemetrygen")
+
+	traceID := newTraceID()
+	start := pcommon.NewTimestampFromTime(now)
+	end := pcommon.NewTimestampFromTime(now.Add(w.cfg.SpanDuration))
+
+	parent := ss.Spans().AppendEmpty()
+	parent.SetTraceID(traceID)
+	parent.SetSpanID(newSpanID())
+	parent.SetName("lets-go")
+	parent.SetKind(ptrace.SpanKindClient)
+	parent.SetStartTimestamp(start)
+	parent.SetEndTimestamp(end)
+	parent.Attributes().UpsertString("net.peer.ip", fakeIP)
+	parent.Attributes().UpsertString("peer.service", "telemetrygen-server")
+	common.InsertTelemetryAttributes(&w.cfg.Config, parent.Attributes())
+
+	for i := 0; i < w.cfg.NumChildSpans; i++ {
+		child := ss.Spans().AppendEmpty()
+		child.SetTraceID(traceID)
+		child.SetSpanID(newSpanID())
+		child.SetParentSpanID(parent.SpanID())
+		child.SetName("okey-dokey")
+		child.SetKind(ptrace.SpanKindServer)
+		child.SetStartTimestamp(start)
+		child.SetEndTimestamp(end)
+		child.Attributes().UpsertString("net.peer.ip", fakeIP)
+		child.Attributes().UpsertString("peer.service", "telemetrygen-client")
+		common.InsertTelemetryAttributes(&w.cfg.Config, child.Attributes())
+	}
+	return td
+}
+
+func newTraceID() pcommon.TraceID {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return pcommon.NewTraceID(id)
+}
+
+func newSpanID() pcommon.SpanID {
+	var id [8]byte
+	_, _ = rand.Read(id[:])
+	return pcommon.NewSpanID(id)
+}
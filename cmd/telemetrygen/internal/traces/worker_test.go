@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+type mockExporter struct {
+	mu     sync.Mutex
+	traces []ptrace.Traces
+}
+
+func (m *mockExporter) ExportTraces(_ context.Context, td ptrace.Traces) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.traces = append(m.traces, td)
+	return nil
+}
+
+func (m *mockExporter) ExportMetrics(context.Context, pmetric.Metrics) error { return nil }
+func (m *mockExporter) ExportLogs(context.Context, plog.Logs) error          { return nil }
+func (m *mockExporter) Shutdown() error                                      { return nil }
+
+func TestFixedNumberOfTraces(t *testing.T) {
+	// prepare
+	exp := &mockExporter{}
+	cfg := &Config{
+		Config: common.Config{
+			WorkerCount:         2,
+			ServiceName:         "svc",
+			ResourceAttributes:  map[string]string{"env": "test"},
+			TelemetryAttributes: map[string]string{"key": "value"},
+		},
+		NumTraces:     3,
+		NumChildSpans: 2,
+		SpanDuration:  time.Millisecond,
+	}
+
+	// test
+	require.NoError(t, Run(cfg, exp, zap.NewNop()))
+
+	// verify
+	require.Len(t, exp.traces, 6)
+	td := exp.traces[0]
+	assert.Equal(t, 3, td.SpanCount()) // each trace has one parent and two child spans
+	rs := td.ResourceSpans().At(0)
+	svc, _ := rs.Resource().Attributes().Get("service.name")
+	assert.Equal(t, "svc", svc.StringVal())
+	env, _ := rs.Resource().Attributes().Get("env")
+	assert.Equal(t, "test", env.StringVal())
+
+	spans := rs.ScopeSpans().At(0).Spans()
+	parent := spans.At(0)
+	assert.Equal(t, time.Millisecond, parent.EndTimestamp().AsTime().Sub(parent.StartTimestamp().AsTime()))
+	for i := 1; i < spans.Len(); i++ {
+		assert.Equal(t, parent.TraceID(), spans.At(i).TraceID())
+		assert.Equal(t, parent.SpanID(), spans.At(i).ParentSpanID())
+		v, _ := spans.At(i).Attributes().Get("key")
+		assert.Equal(t, "value", v.StringVal())
+	}
+}
+
+func TestRateOfTraces(t *testing.T) {
+	// prepare
+	exp := &mockExporter{}
+	cfg := &Config{
+		Config: common.Config{
+			WorkerCount:   1,
+			Rate:          10,
+			TotalDuration: time.Second / 2,
+		},
+	}
+
+	// test
+	require.NoError(t, Run(cfg, exp, zap.NewNop()))
+
+	// verify
+	// the minimum acceptable number of traces for the rate of 10/sec for half a second
+	assert.True(t, len(exp.traces) >= 5, "there should have been more than 5 traces, had %d", len(exp.traces))
+	// the maximum acceptable number of traces for the rate of 10/sec for half a second
+	assert.True(t, len(exp.traces) <= 20, "there should have been less than 20 traces, had %d", len(exp.traces))
+}
+
+func TestNoTracesRequested(t *testing.T) {
+	cfg := &Config{
+		Config: common.Config{WorkerCount: 1},
+	}
+	assert.Error(t, Run(cfg, &mockExporter{}, zap.NewNop()))
+}
@@ -16,8 +16,18 @@ package fileexporter // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
+const (
+	// formatTypeJSON writes each request as a line of Protobuf-JSON.
+	formatTypeJSON = "json"
+	// formatTypeProto writes each request as binary Protobuf, prefixed by its length.
+	formatTypeProto = "otlp_proto"
 )
 
 // Config defines configuration for file exporter.
@@ -26,6 +36,43 @@ type Config struct {
 
 	// Path of the file to write to. Path is relative to current directory.
 	Path string `mapstructure:"path"`
+
+	// Rotation defines when the file is rotated and how many rotated files are kept.
+	// The file is never rotated when it is not set.
+	Rotation *Rotation `mapstructure:"rotation"`
+
+	// FormatType is the encoding of the written requests, either json (default) or otlp_proto.
+	FormatType string `mapstructure:"format"`
+
+	// Compression compresses each written request, either with gzip or zstd.
+	// Requests are not compressed by default.
+	Compression configcompression.CompressionType `mapstructure:"compression"`
+
+	// FlushInterval is how often buffered requests are written to the file.
+	// Requests are written as soon as they are received when it is zero.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
+// Rotation defines the rotation of the file written by the exporter.
+type Rotation struct {
+	// MaxMegabytes is the maximum size in megabytes of the file before it is rotated.
+	// It defaults to 100 megabytes when it is zero.
+	MaxMegabytes int `mapstructure:"max_megabytes"`
+
+	// Interval is the maximum time the same file is written to before it is rotated.
+	// The file is only rotated on size when it is zero.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// MaxDays is the maximum number of days rotated files are kept.
+	// Rotated files are not removed because of their age when it is zero.
+	MaxDays int `mapstructure:"max_days"`
+
+	// MaxBackups is the maximum number of rotated files kept.
+	// All rotated files are kept when it is zero.
+	MaxBackups int `mapstructure:"max_backups"`
+
+	// LocalTime uses the local time instead of UTC in the names of rotated files.
+	LocalTime bool `mapstructure:"localtime"`
 }
 
 var _ config.Exporter = (*Config)(nil)
@@ -36,5 +83,27 @@ func (cfg *Config) Validate() error {
 		return errors.New("path must be non-empty")
 	}
 
+	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
+		return fmt.Errorf("format %q is not supported, must be %q or %q", cfg.FormatType, formatTypeJSON, formatTypeProto)
+	}
+
+	switch cfg.Compression {
+	case configcompression.Gzip, configcompression.Zstd:
+	default:
+		if configcompression.IsCompressed(cfg.Compression) {
+			return fmt.Errorf("compression %q is not supported, must be %q or %q", cfg.Compression, configcompression.Gzip, configcompression.Zstd)
+		}
+	}
+
+	if cfg.FlushInterval < 0 {
+		return errors.New("flush_interval must not be negative")
+	}
+
+	if cfg.Rotation != nil {
+		if cfg.Rotation.MaxMegabytes < 0 || cfg.Rotation.Interval < 0 || cfg.Rotation.MaxDays < 0 || cfg.Rotation.MaxBackups < 0 {
+			return errors.New("rotation max_megabytes, interval, max_days and max_backups must not be negative")
+		}
+	}
+
 	return nil
 }
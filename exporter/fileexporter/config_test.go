@@ -17,11 +17,13 @@ package fileexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/service/servicetest"
 )
 
@@ -43,5 +45,82 @@ func TestLoadConfig(t *testing.T) {
 		&Config{
 			ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "2")),
 			Path:             "./filename.json",
+			FormatType:       formatTypeJSON,
 		})
+
+	e2 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "3")]
+	assert.Equal(t, e2,
+		&Config{
+			ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "3")),
+			Path:             "./filename.pb.zst",
+			FormatType:       formatTypeProto,
+			Compression:      configcompression.Zstd,
+			FlushInterval:    5 * time.Second,
+			Rotation: &Rotation{
+				MaxMegabytes: 10,
+				Interval:     time.Hour,
+				MaxDays:      3,
+				MaxBackups:   3,
+				LocalTime:    true,
+			},
+		})
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		err    string
+	}{
+		{
+			name:   "valid",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:   "empty path",
+			modify: func(cfg *Config) { cfg.Path = "" },
+			err:    "path must be non-empty",
+		},
+		{
+			name:   "unknown format",
+			modify: func(cfg *Config) { cfg.FormatType = "xml" },
+			err:    `format "xml" is not supported, must be "json" or "otlp_proto"`,
+		},
+		{
+			name:   "unsupported compression",
+			modify: func(cfg *Config) { cfg.Compression = configcompression.Snappy },
+			err:    `compression "snappy" is not supported, must be "gzip" or "zstd"`,
+		},
+		{
+			name:   "no compression",
+			modify: func(cfg *Config) { cfg.Compression = "none" },
+		},
+		{
+			name:   "negative flush interval",
+			modify: func(cfg *Config) { cfg.FlushInterval = -time.Second },
+			err:    "flush_interval must not be negative",
+		},
+		{
+			name:   "negative rotation size",
+			modify: func(cfg *Config) { cfg.Rotation = &Rotation{MaxMegabytes: -1} },
+			err:    "rotation max_megabytes, interval, max_days and max_backups must not be negative",
+		},
+		{
+			name:   "default rotation",
+			modify: func(cfg *Config) { cfg.Rotation = &Rotation{} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Path = "./filename.json"
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
 }
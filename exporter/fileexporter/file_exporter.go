@@ -15,29 +15,85 @@
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/binary"
 	"io"
 	"os"
 	"sync"
+	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
-// Marshaler configuration used for marhsaling Protobuf to JSON.
-var tracesMarshaler = ptrace.NewJSONMarshaler()
-var metricsMarshaler = pmetric.NewJSONMarshaler()
-var logsMarshaler = plog.NewJSONMarshaler()
+// bufferSize is the size above which buffered requests are written to the file without
+// waiting for the flush interval.
+const bufferSize = 64 * 1024
+
+// Marshalers used for each of the supported formats.
+var tracesMarshalers = map[string]ptrace.Marshaler{
+	formatTypeJSON:  ptrace.NewJSONMarshaler(),
+	formatTypeProto: ptrace.NewProtoMarshaler(),
+}
+var metricsMarshalers = map[string]pmetric.Marshaler{
+	formatTypeJSON:  pmetric.NewJSONMarshaler(),
+	formatTypeProto: pmetric.NewProtoMarshaler(),
+}
+var logsMarshalers = map[string]plog.Marshaler{
+	formatTypeJSON:  plog.NewJSONMarshaler(),
+	formatTypeProto: plog.NewProtoMarshaler(),
+}
+
+// zstdEncoder is shared by all the exporters, as it is safe to use concurrently with EncodeAll.
+var zstdEncoder, _ = zstd.NewWriter(nil)
 
 // fileExporter is the implementation of file exporter that writes telemetry data to a file
-// in Protobuf-JSON format.
+// in Protobuf-JSON format, or in length-delimited Protobuf format.
 type fileExporter struct {
-	path  string
+	logger        *zap.Logger
+	path          string
+	formatType    string
+	compression   configcompression.CompressionType
+	flushInterval time.Duration
+	rotation      *Rotation
+
+	tracesMarshaler  ptrace.Marshaler
+	metricsMarshaler pmetric.Marshaler
+	logsMarshaler    plog.Marshaler
+
 	file  io.WriteCloser
 	mutex sync.Mutex
+	// buffer holds the requests not written to the file yet when a flush interval is set.
+	buffer bytes.Buffer
+	// written reports whether anything was written to the file since it was last rotated.
+	written bool
+
+	stop       chan struct{}
+	shutdownWg sync.WaitGroup
+}
+
+func newFileExporter(cfg *Config, logger *zap.Logger) *fileExporter {
+	return &fileExporter{
+		logger:           logger,
+		path:             cfg.Path,
+		formatType:       cfg.FormatType,
+		compression:      cfg.Compression,
+		flushInterval:    cfg.FlushInterval,
+		rotation:         cfg.Rotation,
+		tracesMarshaler:  tracesMarshalers[cfg.FormatType],
+		metricsMarshaler: metricsMarshalers[cfg.FormatType],
+		logsMarshaler:    logsMarshalers[cfg.FormatType],
+	}
 }
 
 func (e *fileExporter) Capabilities() consumer.Capabilities {
@@ -45,49 +101,175 @@ func (e *fileExporter) Capabilities() consumer.Capabilities {
 }
 
 func (e *fileExporter) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
-	buf, err := tracesMarshaler.MarshalTraces(td)
+	buf, err := e.tracesMarshaler.MarshalTraces(td)
 	if err != nil {
 		return err
 	}
-	return exportMessageAsLine(e, buf)
+	return e.export(buf)
 }
 
 func (e *fileExporter) ConsumeMetrics(_ context.Context, md pmetric.Metrics) error {
-	buf, err := metricsMarshaler.MarshalMetrics(md)
+	buf, err := e.metricsMarshaler.MarshalMetrics(md)
 	if err != nil {
 		return err
 	}
-	return exportMessageAsLine(e, buf)
+	return e.export(buf)
 }
 
 func (e *fileExporter) ConsumeLogs(_ context.Context, ld plog.Logs) error {
-	buf, err := logsMarshaler.MarshalLogs(ld)
+	buf, err := e.logsMarshaler.MarshalLogs(ld)
 	if err != nil {
 		return err
 	}
-	return exportMessageAsLine(e, buf)
+	return e.export(buf)
 }
 
-func exportMessageAsLine(e *fileExporter, buf []byte) error {
+// export writes the marshaled request to the file, or to the buffer when a flush interval is set.
+func (e *fileExporter) export(buf []byte) error {
+	msg, err := e.encode(buf)
+	if err != nil {
+		return err
+	}
+
 	// Ensure only one write operation happens at a time.
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
-	if _, err := e.file.Write(buf); err != nil {
-		return err
+	if e.flushInterval <= 0 {
+		return e.write(msg)
+	}
+	if e.buffer.Len() > 0 && e.buffer.Len()+len(msg) > bufferSize {
+		if err := e.flushLocked(); err != nil {
+			return err
+		}
+	}
+	e.buffer.Write(msg)
+	return nil
+}
+
+// encode frames the marshaled request, either as a line of JSON or as Protobuf prefixed by
+// its length, and compresses the result as a whole. As every request is a complete gzip
+// member or zstd frame, a file decompresses to the same content as an uncompressed one.
+func (e *fileExporter) encode(buf []byte) ([]byte, error) {
+	var msg []byte
+	if e.formatType == formatTypeProto {
+		msg = make([]byte, 4, 4+len(buf))
+		binary.BigEndian.PutUint32(msg, uint32(len(buf)))
+		msg = append(msg, buf...)
+	} else {
+		msg = append(buf, '\n')
 	}
-	if _, err := io.WriteString(e.file, "\n"); err != nil {
+
+	switch e.compression {
+	case configcompression.Gzip:
+		var compressed bytes.Buffer
+		zw := gzip.NewWriter(&compressed)
+		if _, err := zw.Write(msg); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		return compressed.Bytes(), nil
+	case configcompression.Zstd:
+		return zstdEncoder.EncodeAll(msg, make([]byte, 0, len(msg))), nil
+	default:
+		return msg, nil
+	}
+}
+
+// write writes msg to the file in a single call, so that a request is never split across
+// rotated files. It must be called with the mutex held.
+func (e *fileExporter) write(msg []byte) error {
+	if _, err := e.file.Write(msg); err != nil {
 		return err
 	}
+	e.written = true
 	return nil
 }
 
+// flushLocked writes the buffered requests to the file. It must be called with the mutex held.
+func (e *fileExporter) flushLocked() error {
+	if e.buffer.Len() == 0 {
+		return nil
+	}
+	defer e.buffer.Reset()
+	return e.write(e.buffer.Bytes())
+}
+
+func (e *fileExporter) flush() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.flushLocked()
+}
+
+// rotate flushes the buffered requests and rotates the file, unless nothing was written to it
+// since it was last rotated.
+func (e *fileExporter) rotate() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if err := e.flushLocked(); err != nil {
+		return err
+	}
+	if !e.written {
+		return nil
+	}
+	e.written = false
+	return e.file.(*lumberjack.Logger).Rotate()
+}
+
 func (e *fileExporter) Start(context.Context, component.Host) error {
-	var err error
-	e.file, err = os.OpenFile(e.path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
-	return err
+	if e.rotation != nil {
+		e.file = &lumberjack.Logger{
+			Filename:   e.path,
+			MaxSize:    e.rotation.MaxMegabytes,
+			MaxAge:     e.rotation.MaxDays,
+			MaxBackups: e.rotation.MaxBackups,
+			LocalTime:  e.rotation.LocalTime,
+		}
+	} else {
+		var err error
+		e.file, err = os.OpenFile(e.path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+	}
+
+	e.stop = make(chan struct{})
+	if e.flushInterval > 0 {
+		e.runEvery("flush", e.flushInterval, e.flush)
+	}
+	if e.rotation != nil && e.rotation.Interval > 0 {
+		e.runEvery("rotate", e.rotation.Interval, e.rotate)
+	}
+	return nil
+}
+
+// runEvery calls fn at every interval until the exporter is shut down. As there is no caller
+// to report them to, errors are logged.
+func (e *fileExporter) runEvery(name string, interval time.Duration, fn func() error) {
+	e.shutdownWg.Add(1)
+	go func() {
+		defer e.shutdownWg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := fn(); err != nil {
+					e.logger.Error("Failed to "+name+" the file", zap.Error(err))
+				}
+			case <-e.stop:
+				return
+			}
+		}
+	}()
 }
 
 // Shutdown stops the exporter and is invoked during shutdown.
 func (e *fileExporter) Shutdown(context.Context) error {
-	return e.file.Close()
+	if e.stop != nil {
+		close(e.stop)
+		e.shutdownWg.Wait()
+	}
+	return multierr.Append(e.flush(), e.file.Close())
 }
@@ -14,24 +14,34 @@
 package fileexporter
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/binary"
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
 )
 
 func TestFileTracesExporter(t *testing.T) {
-	fe := &fileExporter{path: tempFileName(t)}
+	fe := newFileExporter(&Config{Path: tempFileName(t), FormatType: formatTypeJSON}, zap.NewNop())
 	require.NotNil(t, fe)
 
 	td := testdata.GenerateTracesTwoSpansSameResource()
@@ -49,7 +59,8 @@ func TestFileTracesExporter(t *testing.T) {
 
 func TestFileTracesExporterError(t *testing.T) {
 	mf := &errorWriter{}
-	fe := &fileExporter{file: mf}
+	fe := newFileExporter(&Config{FormatType: formatTypeJSON}, zap.NewNop())
+	fe.file = mf
 	require.NotNil(t, fe)
 
 	td := testdata.GenerateTracesTwoSpansSameResource()
@@ -59,7 +70,7 @@ func TestFileTracesExporterError(t *testing.T) {
 }
 
 func TestFileMetricsExporter(t *testing.T) {
-	fe := &fileExporter{path: tempFileName(t)}
+	fe := newFileExporter(&Config{Path: tempFileName(t), FormatType: formatTypeJSON}, zap.NewNop())
 	require.NotNil(t, fe)
 
 	md := testdata.GenerateMetricsTwoMetrics()
@@ -77,7 +88,8 @@ func TestFileMetricsExporter(t *testing.T) {
 
 func TestFileMetricsExporterError(t *testing.T) {
 	mf := &errorWriter{}
-	fe := &fileExporter{file: mf}
+	fe := newFileExporter(&Config{FormatType: formatTypeJSON}, zap.NewNop())
+	fe.file = mf
 	require.NotNil(t, fe)
 
 	md := testdata.GenerateMetricsTwoMetrics()
@@ -87,7 +99,7 @@ func TestFileMetricsExporterError(t *testing.T) {
 }
 
 func TestFileLogsExporter(t *testing.T) {
-	fe := &fileExporter{path: tempFileName(t)}
+	fe := newFileExporter(&Config{Path: tempFileName(t), FormatType: formatTypeJSON}, zap.NewNop())
 	require.NotNil(t, fe)
 
 	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
@@ -105,7 +117,8 @@ func TestFileLogsExporter(t *testing.T) {
 
 func TestFileLogsExporterErrors(t *testing.T) {
 	mf := &errorWriter{}
-	fe := &fileExporter{file: mf}
+	fe := newFileExporter(&Config{FormatType: formatTypeJSON}, zap.NewNop())
+	fe.file = mf
 	require.NotNil(t, fe)
 
 	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
@@ -114,6 +127,192 @@ func TestFileLogsExporterErrors(t *testing.T) {
 	assert.NoError(t, fe.Shutdown(context.Background()))
 }
 
+func TestFileExporterFormatAndCompression(t *testing.T) {
+	unmarshalers := map[string]ptrace.Unmarshaler{
+		formatTypeJSON:  ptrace.NewJSONUnmarshaler(),
+		formatTypeProto: ptrace.NewProtoUnmarshaler(),
+	}
+	for _, format := range []string{formatTypeJSON, formatTypeProto} {
+		for _, compression := range []configcompression.CompressionType{"", configcompression.Gzip, configcompression.Zstd} {
+			t.Run(format+"/"+string(compression), func(t *testing.T) {
+				// prepare
+				fe := newFileExporter(&Config{
+					Path:        tempFileName(t),
+					FormatType:  format,
+					Compression: compression,
+				}, zap.NewNop())
+				td := testdata.GenerateTracesTwoSpansSameResource()
+				require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+				// test
+				require.NoError(t, fe.ConsumeTraces(context.Background(), td))
+				require.NoError(t, fe.ConsumeTraces(context.Background(), td))
+				require.NoError(t, fe.Shutdown(context.Background()))
+
+				// verify
+				msgs := readMessages(t, fe.path, format, compression)
+				require.Len(t, msgs, 2)
+				for _, msg := range msgs {
+					got, err := unmarshalers[format].UnmarshalTraces(msg)
+					require.NoError(t, err)
+					assert.EqualValues(t, td, got)
+				}
+			})
+		}
+	}
+}
+
+func TestFileExporterFlushInterval(t *testing.T) {
+	// prepare
+	fe := newFileExporter(&Config{
+		Path:          tempFileName(t),
+		FormatType:    formatTypeJSON,
+		FlushInterval: 50 * time.Millisecond,
+	}, zap.NewNop())
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, fe.Shutdown(context.Background())) }()
+
+	// test
+	require.NoError(t, fe.ConsumeLogs(context.Background(), ld))
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return len(readMessages(t, fe.path, formatTypeJSON, "")) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestFileExporterFlushOnShutdown(t *testing.T) {
+	// prepare
+	fe := newFileExporter(&Config{
+		Path:          tempFileName(t),
+		FormatType:    formatTypeJSON,
+		FlushInterval: time.Hour,
+	}, zap.NewNop())
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+	// test
+	require.NoError(t, fe.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, fe.ConsumeLogs(context.Background(), ld))
+	assert.Empty(t, readMessages(t, fe.path, formatTypeJSON, ""))
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	// verify
+	assert.Len(t, readMessages(t, fe.path, formatTypeJSON, ""), 2)
+}
+
+func TestFileExporterRotateOnSize(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	fe := newFileExporter(&Config{
+		Path:          filepath.Join(dir, "data.json"),
+		FormatType:    formatTypeJSON,
+		FlushInterval: time.Hour,
+		Rotation:      &Rotation{MaxMegabytes: 1, MaxBackups: 2},
+	}, zap.NewNop())
+	md := testdata.GenerateMetricsManyMetricsSameResource(500)
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+	// test
+	for i := 0; i < 15; i++ {
+		require.NoError(t, fe.ConsumeMetrics(context.Background(), md))
+	}
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	// verify
+	assert.Eventually(t, func() bool {
+		// old backups are removed in the background
+		files, err := filepath.Glob(filepath.Join(dir, "*"))
+		require.NoError(t, err)
+		return len(files) == 3
+	}, time.Second, 10*time.Millisecond)
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	for _, file := range files {
+		info, err := os.Stat(file)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(1024*1024))
+		// every file holds complete requests
+		for _, msg := range readMessages(t, file, formatTypeJSON, "") {
+			got, err := pmetric.NewJSONUnmarshaler().UnmarshalMetrics(msg)
+			require.NoError(t, err)
+			assert.EqualValues(t, md, got)
+		}
+	}
+}
+
+func TestFileExporterRotateOnAge(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	fe := newFileExporter(&Config{
+		Path:       filepath.Join(dir, "data.json"),
+		FormatType: formatTypeJSON,
+		Rotation:   &Rotation{Interval: 50 * time.Millisecond},
+	}, zap.NewNop())
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, fe.Shutdown(context.Background())) }()
+
+	// test
+	require.NoError(t, fe.ConsumeLogs(context.Background(), ld))
+
+	// verify
+	assert.Eventually(t, func() bool {
+		files, err := filepath.Glob(filepath.Join(dir, "data-*.json"))
+		require.NoError(t, err)
+		return len(files) == 1
+	}, time.Second, 10*time.Millisecond)
+	// nothing was written since, so the file is not rotated again
+	time.Sleep(200 * time.Millisecond)
+	files, err := filepath.Glob(filepath.Join(dir, "data-*.json"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Len(t, readMessages(t, files[0], formatTypeJSON, ""), 1)
+}
+
+// readMessages reads the file written by the exporter and returns the marshaled requests it holds.
+func readMessages(t *testing.T, path string, format string, compression configcompression.CompressionType) [][]byte {
+	buf, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	if len(buf) == 0 {
+		return nil
+	}
+
+	switch compression {
+	case configcompression.Gzip:
+		zr, err := gzip.NewReader(bytes.NewReader(buf))
+		require.NoError(t, err)
+		buf, err = io.ReadAll(zr)
+		require.NoError(t, err)
+	case configcompression.Zstd:
+		zr, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer zr.Close()
+		buf, err = zr.DecodeAll(buf, nil)
+		require.NoError(t, err)
+	}
+
+	var msgs [][]byte
+	if format == formatTypeProto {
+		for len(buf) > 0 {
+			require.GreaterOrEqual(t, len(buf), 4)
+			size := binary.BigEndian.Uint32(buf)
+			require.GreaterOrEqual(t, len(buf), 4+int(size))
+			msgs = append(msgs, buf[4:4+size])
+			buf = buf[4+size:]
+		}
+		return msgs
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	scanner.Buffer(nil, len(buf))
+	for scanner.Scan() {
+		msgs = append(msgs, append([]byte(nil), scanner.Bytes()...))
+	}
+	require.NoError(t, scanner.Err())
+	return msgs
+}
+
 // tempFileName provides a temporary file name for testing.
 func tempFileName(t *testing.T) string {
 	tmpfile, err := ioutil.TempFile("", "*.json")
@@ -17,6 +17,15 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+const (
+	traceIDRouting  = "traceID"
+	svcRouting      = "service"
+	resourceRouting = "resource"
+	metricRouting   = "metric"
 )
 
 // Config defines configuration for the exporter.
@@ -24,6 +33,11 @@ type Config struct {
 	config.ExporterSettings `mapstructure:",squash"`
 	Protocol                Protocol         `mapstructure:"protocol"`
 	Resolver                ResolverSettings `mapstructure:"resolver"`
+
+	// RoutingKey determines which part of the data is used to select the backend.
+	// Traces accept "traceID" (default) and "service", metrics accept "service" (default),
+	// "resource" and "metric". Logs are always routed by trace ID.
+	RoutingKey string `mapstructure:"routing_key"`
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
@@ -35,6 +49,7 @@ type Protocol struct {
 type ResolverSettings struct {
 	Static *StaticResolver `mapstructure:"static"`
 	DNS    *DNSResolver    `mapstructure:"dns"`
+	K8sSvc *K8sSvcResolver `mapstructure:"k8s"`
 }
 
 // StaticResolver defines the configuration for the resolver providing a fixed list of backends
@@ -47,3 +62,13 @@ type DNSResolver struct {
 	Hostname string `mapstructure:"hostname"`
 	Port     string `mapstructure:"port"`
 }
+
+// K8sSvcResolver defines the configuration for the resolver watching the endpoints of a Kubernetes service
+type K8sSvcResolver struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// Service is the name of the service to watch, optionally followed by its namespace, as in "lb-svc.observability"
+	Service string `mapstructure:"service"`
+	// Ports holds the ports to use for each of the service's addresses. Defaults to 4317.
+	Ports []int32 `mapstructure:"ports"`
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/service/servicetest"
 )
 
@@ -33,4 +34,11 @@ func TestLoadConfig(t *testing.T) {
 	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
 	require.NoError(t, err)
 	require.NotNil(t, cfg)
+
+	assert.Equal(t, "", cfg.Exporters[config.NewComponentID(typeStr)].(*Config).RoutingKey)
+	assert.Equal(t, metricRouting, cfg.Exporters[config.NewComponentIDWithName(typeStr, "4")].(*Config).RoutingKey)
+	assert.Equal(t, &K8sSvcResolver{
+		Service: "lb-svc.observability",
+		Ports:   []int32{4317, 55690},
+	}, cfg.Exporters[config.NewComponentIDWithName(typeStr, "k8s")].(*Config).Resolver.K8sSvc)
 }
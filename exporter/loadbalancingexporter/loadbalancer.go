@@ -24,8 +24,9 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
-	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 const (
@@ -41,9 +42,12 @@ var _ loadBalancer = (*loadBalancerImp)(nil)
 
 type componentFactory func(ctx context.Context, endpoint string) (component.Exporter, error)
 
+// k8sClientFactory builds the Kubernetes client for the k8s resolver, replaced in tests
+var k8sClientFactory = k8sconfig.MakeClient
+
 type loadBalancer interface {
 	component.Component
-	Endpoint(traceID pcommon.TraceID) string
+	Endpoint(identifier []byte) string
 	Exporter(endpoint string) (component.Exporter, error)
 }
 
@@ -65,7 +69,7 @@ type loadBalancerImp struct {
 func newLoadBalancer(params component.ExporterCreateSettings, cfg config.Exporter, factory componentFactory) (*loadBalancerImp, error) {
 	oCfg := cfg.(*Config)
 
-	if oCfg.Resolver.DNS != nil && oCfg.Resolver.Static != nil {
+	if numResolvers(oCfg.Resolver) > 1 {
 		return nil, errMultipleResolversProvided
 	}
 
@@ -87,6 +91,25 @@ func newLoadBalancer(params component.ExporterCreateSettings, cfg config.Exporte
 		}
 	}
 
+	if oCfg.Resolver.K8sSvc != nil {
+		k8sLogger := params.Logger.With(zap.String("resolver", "k8s service"))
+
+		apiCfg := oCfg.Resolver.K8sSvc.APIConfig
+		if apiCfg.AuthType == "" {
+			apiCfg.AuthType = k8sconfig.AuthTypeServiceAccount
+		}
+
+		clt, err := k8sClientFactory(apiCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err = newK8sResolver(clt, k8sLogger, oCfg.Resolver.K8sSvc.Service, oCfg.Resolver.K8sSvc.Ports)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if res == nil {
 		return nil, errNoResolver
 	}
@@ -99,6 +122,20 @@ func newLoadBalancer(params component.ExporterCreateSettings, cfg config.Exporte
 	}, nil
 }
 
+func numResolvers(settings ResolverSettings) int {
+	num := 0
+	if settings.Static != nil {
+		num++
+	}
+	if settings.DNS != nil {
+		num++
+	}
+	if settings.K8sSvc != nil {
+		num++
+	}
+	return num
+}
+
 func (lb *loadBalancerImp) Start(ctx context.Context, host component.Host) error {
 	lb.res.onChange(lb.onBackendChanges)
 	lb.host = host
@@ -174,11 +211,11 @@ func (lb *loadBalancerImp) Shutdown(context.Context) error {
 	return nil
 }
 
-func (lb *loadBalancerImp) Endpoint(traceID pcommon.TraceID) string {
+func (lb *loadBalancerImp) Endpoint(identifier []byte) string {
 	lb.updateLock.RLock()
 	defer lb.updateLock.RUnlock()
 
-	return lb.ring.endpointFor(traceID)
+	return lb.ring.endpointFor(identifier)
 }
 
 func (lb *loadBalancerImp) Exporter(endpoint string) (component.Exporter, error) {
@@ -26,7 +26,10 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
-	"go.opentelemetry.io/collector/pdata/pcommon"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 func TestNewLoadBalancerNoResolver(t *testing.T) {
@@ -111,6 +114,38 @@ func TestWithDNSResolver(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestWithK8sResolver(t *testing.T) {
+	// prepare
+	var authType k8sconfig.AuthType
+	defaultFactory := k8sClientFactory
+	k8sClientFactory = func(apiCfg k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		authType = apiCfg.AuthType
+		return fake.NewSimpleClientset(), nil
+	}
+	defer func() {
+		k8sClientFactory = defaultFactory
+	}()
+
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			K8sSvc: &K8sSvcResolver{
+				Service: "lb.observability",
+			},
+		},
+	}
+	p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, nil)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	res, ok := p.res.(*k8sResolver)
+
+	// verify
+	assert.NotNil(t, res)
+	assert.True(t, ok)
+	assert.Equal(t, k8sconfig.AuthTypeServiceAccount, authType)
+}
+
 func TestMultipleResolvers(t *testing.T) {
 	cfg := &Config{
 		Resolver: ResolverSettings{
@@ -120,6 +155,9 @@ func TestMultipleResolvers(t *testing.T) {
 			DNS: &DNSResolver{
 				Hostname: "service-1",
 			},
+			K8sSvc: &K8sSvcResolver{
+				Service: "lb",
+			},
 		},
 	}
 
@@ -342,7 +380,7 @@ func TestFailedExporterInRing(t *testing.T) {
 
 	// test
 	// this trace ID will reach the endpoint-2 -- see the consistent hashing tests for more info
-	_, err = p.Exporter(p.Endpoint(pcommon.NewTraceID([16]byte{128, 128, 0, 0})))
+	_, err = p.Exporter(p.Endpoint([]byte{128, 128, 0, 0}))
 
 	// verify
 	assert.Error(t, err)
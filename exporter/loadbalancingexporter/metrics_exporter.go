@@ -0,0 +1,274 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+)
+
+var _ component.MetricsExporter = (*metricExporterImp)(nil)
+
+type metricExporterImp struct {
+	loadBalancer loadBalancer
+	routingKey   string
+
+	stopped    bool
+	shutdownWg sync.WaitGroup
+}
+
+// Create new metrics exporter
+func newMetricsExporter(params component.ExporterCreateSettings, cfg config.Exporter) (*metricExporterImp, error) {
+	routingKey := cfg.(*Config).RoutingKey
+	switch routingKey {
+	case "":
+		routingKey = svcRouting
+	case svcRouting, resourceRouting, metricRouting:
+	default:
+		return nil, fmt.Errorf("unsupported routing_key %q for metrics, must be one of %q, %q or %q", routingKey, svcRouting, resourceRouting, metricRouting)
+	}
+
+	exporterFactory := otlpexporter.NewFactory()
+
+	lb, err := newLoadBalancer(params, cfg, func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
+		return exporterFactory.CreateMetricsExporter(ctx, params, &oCfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &metricExporterImp{
+		loadBalancer: lb,
+		routingKey:   routingKey,
+	}, nil
+}
+
+func (e *metricExporterImp) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (e *metricExporterImp) Start(ctx context.Context, host component.Host) error {
+	return e.loadBalancer.Start(ctx, host)
+}
+
+func (e *metricExporterImp) Shutdown(context.Context) error {
+	e.stopped = true
+	e.shutdownWg.Wait()
+	return nil
+}
+
+func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var batches map[string]pmetric.Metrics
+	if e.routingKey == metricRouting {
+		batches = e.splitByStream(md)
+	} else {
+		batches = e.splitByResource(md)
+	}
+
+	var errs error
+	for endpoint, batch := range batches {
+		errs = multierr.Append(errs, e.consumeMetric(ctx, endpoint, batch))
+	}
+
+	return errs
+}
+
+// splitByResource groups the resource metrics based on either their service name or their full set
+// of resource attributes, returning one batch per backend
+func (e *metricExporterImp) splitByResource(md pmetric.Metrics) map[string]pmetric.Metrics {
+	batches := map[string]pmetric.Metrics{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+
+		var identifier []byte
+		if e.routingKey == resourceRouting {
+			identifier = resourceIdentifier(rm.Resource())
+		} else {
+			identifier = []byte(serviceName(rm.Resource()))
+		}
+
+		endpoint := e.loadBalancer.Endpoint(identifier)
+		rm.CopyTo(batchFor(batches, endpoint).ResourceMetrics().AppendEmpty())
+	}
+
+	return batches
+}
+
+// splitByStream distributes the individual data points based on the stream they belong to, made of the
+// resource attributes, metric name and data point attributes, returning one batch per backend
+func (e *metricExporterImp) splitByStream(md pmetric.Metrics) map[string]pmetric.Metrics {
+	batches := map[string]pmetric.Metrics{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+
+		// the resource and scope for each backend are created on demand, as not all backends
+		// will receive data points from this resource
+		resources := map[string]pmetric.ResourceMetrics{}
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+
+			scopes := map[string]pmetric.ScopeMetrics{}
+			metrics := sm.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+
+				// find the backend for each data point of the metric
+				var endpoints []string
+				for _, attrs := range dataPointAttributes(metric) {
+					endpoints = append(endpoints, e.loadBalancer.Endpoint(streamIdentifier(rm.Resource(), metric.Name(), attrs)))
+				}
+
+				copied := map[string]bool{}
+				for _, endpoint := range endpoints {
+					if copied[endpoint] {
+						continue
+					}
+					copied[endpoint] = true
+
+					scope, found := scopes[endpoint]
+					if !found {
+						res, found := resources[endpoint]
+						if !found {
+							res = batchFor(batches, endpoint).ResourceMetrics().AppendEmpty()
+							rm.Resource().CopyTo(res.Resource())
+							res.SetSchemaUrl(rm.SchemaUrl())
+							resources[endpoint] = res
+						}
+						scope = res.ScopeMetrics().AppendEmpty()
+						sm.Scope().CopyTo(scope.Scope())
+						scope.SetSchemaUrl(sm.SchemaUrl())
+						scopes[endpoint] = scope
+					}
+
+					dest := scope.Metrics().AppendEmpty()
+					metric.CopyTo(dest)
+					endpoint := endpoint
+					idx := 0
+					removeDataPoints(dest, func() bool {
+						remove := endpoints[idx] != endpoint
+						idx++
+						return remove
+					})
+				}
+			}
+		}
+	}
+
+	return batches
+}
+
+func (e *metricExporterImp) consumeMetric(ctx context.Context, endpoint string, md pmetric.Metrics) error {
+	exp, err := e.loadBalancer.Exporter(endpoint)
+	if err != nil {
+		return err
+	}
+
+	me, ok := exp.(component.MetricsExporter)
+	if !ok {
+		expectType := (*component.MetricsExporter)(nil)
+		return fmt.Errorf("unable to export metrics, unexpected exporter type: expected %T but got %T", expectType, exp)
+	}
+
+	start := time.Now()
+	err = me.ConsumeMetrics(ctx, md)
+	duration := time.Since(start)
+	ctx, _ = tag.New(ctx, tag.Upsert(tag.MustNewKey("endpoint"), endpoint))
+
+	if err == nil {
+		sCtx, _ := tag.New(ctx, tag.Upsert(tag.MustNewKey("success"), "true"))
+		stats.Record(sCtx, mBackendLatency.M(duration.Milliseconds()))
+	} else {
+		fCtx, _ := tag.New(ctx, tag.Upsert(tag.MustNewKey("success"), "false"))
+		stats.Record(fCtx, mBackendLatency.M(duration.Milliseconds()))
+	}
+
+	return err
+}
+
+// batchFor returns the batch for the given endpoint, creating it when it doesn't exist yet
+func batchFor(batches map[string]pmetric.Metrics, endpoint string) pmetric.Metrics {
+	batch, found := batches[endpoint]
+	if !found {
+		batch = pmetric.NewMetrics()
+		batches[endpoint] = batch
+	}
+	return batch
+}
+
+// dataPointAttributes returns the attributes for each data point of the metric, in the same order as the data points
+func dataPointAttributes(metric pmetric.Metric) []pcommon.Map {
+	var attrs []pcommon.Map
+	switch metric.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricDataTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricDataTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricDataTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	}
+	return attrs
+}
+
+// removeDataPoints removes the data points for which the given function returns true. The function
+// is called once for each data point, in order.
+func removeDataPoints(metric pmetric.Metric, remove func() bool) {
+	switch metric.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		metric.Gauge().DataPoints().RemoveIf(func(pmetric.NumberDataPoint) bool { return remove() })
+	case pmetric.MetricDataTypeSum:
+		metric.Sum().DataPoints().RemoveIf(func(pmetric.NumberDataPoint) bool { return remove() })
+	case pmetric.MetricDataTypeHistogram:
+		metric.Histogram().DataPoints().RemoveIf(func(pmetric.HistogramDataPoint) bool { return remove() })
+	case pmetric.MetricDataTypeExponentialHistogram:
+		metric.ExponentialHistogram().DataPoints().RemoveIf(func(pmetric.ExponentialHistogramDataPoint) bool { return remove() })
+	case pmetric.MetricDataTypeSummary:
+		metric.Summary().DataPoints().RemoveIf(func(pmetric.SummaryDataPoint) bool { return remove() })
+	}
+}
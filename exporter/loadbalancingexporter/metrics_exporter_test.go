@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:errcheck
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+func TestNewMetricsExporter(t *testing.T) {
+	for _, tt := range []struct {
+		desc   string
+		config *Config
+		err    error
+	}{
+		{
+			"simple",
+			simpleConfig(),
+			nil,
+		},
+		{
+			"empty",
+			&Config{},
+			errNoResolver,
+		},
+		{
+			"unsupported routing key",
+			func() *Config {
+				cfg := simpleConfig()
+				cfg.RoutingKey = traceIDRouting
+				return cfg
+			}(),
+			errors.New(`unsupported routing_key "traceID" for metrics, must be one of "service", "resource" or "metric"`),
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			_, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), tt.config)
+
+			// verify
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestMetricsExporterStartAndShutdown(t *testing.T) {
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), simpleConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	res := p.Shutdown(context.Background())
+
+	// verify
+	assert.Nil(t, res)
+}
+
+func TestConsumeMetricsUnexpectedExporterType(t *testing.T) {
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newNopMockExporter(), nil
+	}
+	lb, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), simpleConfig(), componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), simpleConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// pre-load an exporter here, so that we don't use the actual OTLP exporter
+	lb.exporters["endpoint-1"] = newNopMockExporter()
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(ctx context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer p.Shutdown(context.Background())
+
+	// test
+	res := p.ConsumeMetrics(context.Background(), metricsWithServices("svc-1"))
+
+	// verify
+	assert.EqualError(t, res, fmt.Sprintf("unable to export metrics, unexpected exporter type: expected *component.MetricsExporter but got %T", newNopMockExporter()))
+}
+
+func TestConsumeMetricsByService(t *testing.T) {
+	// prepare
+	p, sinks := metricsExporterWithSinks(t, svcRouting)
+	md := metricsWithServices("svc-1", "svc-2", "svc-3", "svc-4", "svc-1")
+
+	// test
+	err := p.ConsumeMetrics(context.Background(), md)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, md.DataPointCount(), dataPointsInSinks(sinks))
+
+	// each service should be found in one backend only
+	backendsPerService := map[string]map[string]bool{}
+	for endpoint, sink := range sinks {
+		for _, received := range sink.AllMetrics() {
+			rms := received.ResourceMetrics()
+			for i := 0; i < rms.Len(); i++ {
+				svc := serviceName(rms.At(i).Resource())
+				if backendsPerService[svc] == nil {
+					backendsPerService[svc] = map[string]bool{}
+				}
+				backendsPerService[svc][endpoint] = true
+			}
+		}
+	}
+	assert.Len(t, backendsPerService, 4)
+	for svc, backends := range backendsPerService {
+		assert.Len(t, backends, 1, "service %q was sent to more than one backend", svc)
+	}
+}
+
+func TestConsumeMetricsByResource(t *testing.T) {
+	// prepare
+	p, sinks := metricsExporterWithSinks(t, resourceRouting)
+	md := metricsWithServices("svc-1", "svc-1")
+	md.ResourceMetrics().At(1).Resource().Attributes().InsertString("host.name", "host-1")
+
+	// test
+	err := p.ConsumeMetrics(context.Background(), md)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, md.DataPointCount(), dataPointsInSinks(sinks))
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		endpoint := p.loadBalancer.Endpoint(resourceIdentifier(md.ResourceMetrics().At(i).Resource()))
+		assert.Greater(t, sinks[endpoint].DataPointCount(), 0)
+	}
+}
+
+func TestConsumeMetricsByStream(t *testing.T) {
+	// prepare
+	p, sinks := metricsExporterWithSinks(t, metricRouting)
+	md := metricsWithServices("svc-1")
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	for i := 0; i < 50; i++ {
+		dp := dps.AppendEmpty()
+		dp.Attributes().InsertString("series", fmt.Sprintf("series-%d", i))
+		dp.SetIntVal(int64(i))
+	}
+
+	// test
+	err := p.ConsumeMetrics(context.Background(), md)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, md.DataPointCount(), dataPointsInSinks(sinks))
+
+	receivingBackends := 0
+	for endpoint, sink := range sinks {
+		if sink.DataPointCount() > 0 {
+			receivingBackends++
+		}
+
+		for _, received := range sink.AllMetrics() {
+			rm := received.ResourceMetrics().At(0)
+			assert.Equal(t, "svc-1", serviceName(rm.Resource()))
+			assert.Equal(t, "scope", rm.ScopeMetrics().At(0).Scope().Name())
+
+			metric := rm.ScopeMetrics().At(0).Metrics().At(0)
+			assert.Equal(t, "requests", metric.Name())
+			assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, metric.Sum().AggregationTemporality())
+
+			// all data points should have been sent to the backend responsible for their stream
+			received := metric.Sum().DataPoints()
+			for i := 0; i < received.Len(); i++ {
+				expected := p.loadBalancer.Endpoint(streamIdentifier(rm.Resource(), metric.Name(), received.At(i).Attributes()))
+				assert.Equal(t, expected, endpoint)
+			}
+		}
+	}
+	assert.Greater(t, receivingBackends, 1)
+}
+
+func metricsExporterWithSinks(t *testing.T, routingKey string) (*metricExporterImp, map[string]*consumertest.MetricsSink) {
+	endpoints := []string{"endpoint-1:4317", "endpoint-2:4317", "endpoint-3:4317"}
+	sinks := map[string]*consumertest.MetricsSink{}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		sink := new(consumertest.MetricsSink)
+		sinks[endpoint] = sink
+		return &mockMetricsExporter{Component: mockComponent{}, MetricsSink: sink}, nil
+	}
+
+	cfg := simpleConfig()
+	cfg.RoutingKey = routingKey
+	lb, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(ctx context.Context) ([]string, error) {
+			return endpoints, nil
+		},
+	}
+
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		p.Shutdown(context.Background())
+	})
+	require.Len(t, sinks, len(endpoints))
+
+	return p, sinks
+}
+
+func metricsWithServices(services ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, svc := range services {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().InsertString(conventions.AttributeServiceName, svc)
+
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName("scope")
+
+		metric := sm.Metrics().AppendEmpty()
+		metric.SetName("requests")
+		metric.SetDataType(pmetric.MetricDataTypeSum)
+		metric.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+		metric.Sum().DataPoints().AppendEmpty().SetIntVal(1)
+	}
+	return md
+}
+
+func dataPointsInSinks(sinks map[string]*consumertest.MetricsSink) int {
+	total := 0
+	for _, sink := range sinks {
+		total += sink.DataPointCount()
+	}
+	return total
+}
+
+type mockMetricsExporter struct {
+	component.Component
+	*consumertest.MetricsSink
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:errcheck
+package loadbalancingexporter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestInitialK8sResolution(t *testing.T) {
+	// prepare
+	clt := fake.NewSimpleClientset(
+		endpointsFor("lb", "observability", "10.0.0.2", "10.0.0.1"),
+		endpointsFor("other", "observability", "10.0.0.3"),
+	)
+	res, err := newK8sResolver(clt, zap.NewNop(), "lb.observability", []int32{4317, 55690})
+	require.NoError(t, err)
+
+	// test
+	resolved := &resolvedEndpoints{}
+	res.onChange(resolved.set)
+	require.NoError(t, res.start(context.Background()))
+	defer res.shutdown(context.Background())
+
+	// verify
+	assert.Equal(t, []string{"10.0.0.1:4317", "10.0.0.1:55690", "10.0.0.2:4317", "10.0.0.2:55690"}, resolved.get())
+}
+
+func TestK8sResolutionWithoutEndpoints(t *testing.T) {
+	// prepare
+	res, err := newK8sResolver(fake.NewSimpleClientset(), zap.NewNop(), "lb", nil)
+	require.NoError(t, err)
+
+	// test
+	resolved := &resolvedEndpoints{}
+	res.onChange(resolved.set)
+	require.NoError(t, res.start(context.Background()))
+	defer res.shutdown(context.Background())
+
+	// verify
+	assert.NotNil(t, resolved.get())
+	assert.Empty(t, resolved.get())
+}
+
+func TestK8sResolverOnChange(t *testing.T) {
+	// prepare
+	clt := fake.NewSimpleClientset(endpointsFor("lb", "default", "10.0.0.1"))
+	res, err := newK8sResolver(clt, zap.NewNop(), "lb", nil)
+	require.NoError(t, err)
+
+	resolved := &resolvedEndpoints{}
+	res.onChange(resolved.set)
+	require.NoError(t, res.start(context.Background()))
+	defer res.shutdown(context.Background())
+	assert.Equal(t, []string{"10.0.0.1:4317"}, resolved.get())
+
+	// test: scale up
+	_, err = clt.CoreV1().Endpoints("default").Update(context.Background(), endpointsFor("lb", "default", "10.0.0.1", "10.0.0.2"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"10.0.0.1:4317", "10.0.0.2:4317"}, resolved.get())
+	}, time.Second, 10*time.Millisecond)
+
+	// test: remove the service
+	err = clt.CoreV1().Endpoints("default").Delete(context.Background(), "lb", metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return len(resolved.get()) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestK8sResolverNamespace(t *testing.T) {
+	for _, tt := range []struct {
+		service   string
+		name      string
+		namespace string
+	}{
+		{"lb", "lb", "default"},
+		{"lb.observability", "lb", "observability"},
+		{"lb.observability.svc.cluster.local", "lb", "observability"},
+	} {
+		t.Run(tt.service, func(t *testing.T) {
+			// test
+			res, err := newK8sResolver(fake.NewSimpleClientset(), zap.NewNop(), tt.service, nil)
+
+			// verify
+			require.NoError(t, err)
+			assert.Equal(t, tt.name, res.svcName)
+			assert.Equal(t, tt.namespace, res.svcNs)
+			assert.Equal(t, []int32{defaultK8sPort}, res.ports)
+		})
+	}
+}
+
+func TestErrNoSvc(t *testing.T) {
+	// test
+	res, err := newK8sResolver(fake.NewSimpleClientset(), zap.NewNop(), "", nil)
+
+	// verify
+	assert.Nil(t, res)
+	assert.Equal(t, errNoSvc, err)
+}
+
+func endpointsFor(name, namespace string, ips ...string) *corev1.Endpoints {
+	eps := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	subset := corev1.EndpointSubset{}
+	for _, ip := range ips {
+		subset.Addresses = append(subset.Addresses, corev1.EndpointAddress{IP: ip})
+	}
+	eps.Subsets = append(eps.Subsets, subset)
+	return eps
+}
+
+// resolvedEndpoints holds the latest endpoints reported by a resolver, as callbacks are invoked from the informer's goroutine
+type resolvedEndpoints struct {
+	mu        sync.Mutex
+	endpoints []string
+}
+
+func (r *resolvedEndpoints) set(endpoints []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.endpoints = endpoints
+}
+
+func (r *resolvedEndpoints) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.endpoints
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// serviceName returns the value of the service.name attribute of the given resource, or an empty
+// string when the attribute isn't set, so that all data without a service name ends up in the same backend
+func serviceName(res pcommon.Resource) string {
+	if svc, ok := res.Attributes().Get(conventions.AttributeServiceName); ok {
+		return svc.AsString()
+	}
+	return ""
+}
+
+// writeAttributes writes a stable representation of the given attributes to the builder,
+// with the keys in lexical order, so that the same set of attributes always results in the same identifier
+func writeAttributes(sb *strings.Builder, attrs pcommon.Map) {
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(v.AsString())
+		sb.WriteByte(';')
+	}
+}
+
+// resourceIdentifier builds the routing identifier for all the resource attributes
+func resourceIdentifier(res pcommon.Resource) []byte {
+	sb := strings.Builder{}
+	writeAttributes(&sb, res.Attributes())
+	return []byte(sb.String())
+}
+
+// streamIdentifier builds the routing identifier for a single metric stream, made of the
+// resource attributes, the metric name and the data point attributes
+func streamIdentifier(res pcommon.Resource, metricName string, dpAttrs pcommon.Map) []byte {
+	sb := strings.Builder{}
+	writeAttributes(&sb, res.Attributes())
+	sb.WriteByte('|')
+	sb.WriteString(metricName)
+	sb.WriteByte('|')
+	writeAttributes(&sb, dpAttrs)
+	return []byte(sb.String())
+}
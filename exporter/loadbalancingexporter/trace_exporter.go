@@ -42,6 +42,7 @@ var (
 
 type traceExporterImp struct {
 	loadBalancer loadBalancer
+	routingKey   string
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -49,6 +50,15 @@ type traceExporterImp struct {
 
 // Create new traces exporter
 func newTracesExporter(params component.ExporterCreateSettings, cfg config.Exporter) (*traceExporterImp, error) {
+	routingKey := cfg.(*Config).RoutingKey
+	switch routingKey {
+	case "":
+		routingKey = traceIDRouting
+	case traceIDRouting, svcRouting:
+	default:
+		return nil, fmt.Errorf("unsupported routing_key %q for traces, must be one of %q or %q", routingKey, traceIDRouting, svcRouting)
+	}
+
 	exporterFactory := otlpexporter.NewFactory()
 
 	lb, err := newLoadBalancer(params, cfg, func(ctx context.Context, endpoint string) (component.Exporter, error) {
@@ -61,6 +71,7 @@ func newTracesExporter(params component.ExporterCreateSettings, cfg config.Expor
 
 	return &traceExporterImp{
 		loadBalancer: lb,
+		routingKey:   routingKey,
 	}, nil
 }
 
@@ -86,6 +97,10 @@ func (e *traceExporterImp) Shutdown(context.Context) error {
 }
 
 func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	if e.routingKey == svcRouting {
+		return e.consumeByService(ctx, td)
+	}
+
 	var errs error
 	batches := batchpersignal.SplitTraces(td)
 	for _, batch := range batches {
@@ -101,7 +116,36 @@ func (e *traceExporterImp) consumeTrace(ctx context.Context, td ptrace.Traces) e
 		return errNoTracesInBatch
 	}
 
-	endpoint := e.loadBalancer.Endpoint(traceID)
+	b := traceID.Bytes()
+	return e.export(ctx, e.loadBalancer.Endpoint(b[:]), td)
+}
+
+// consumeByService groups the resource spans based on their service name, so that all spans
+// for a service are sent to the same backend, regardless of the trace they belong to
+func (e *traceExporterImp) consumeByService(ctx context.Context, td ptrace.Traces) error {
+	batches := map[string]ptrace.Traces{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		endpoint := e.loadBalancer.Endpoint([]byte(serviceName(rs.Resource())))
+
+		batch, found := batches[endpoint]
+		if !found {
+			batch = ptrace.NewTraces()
+			batches[endpoint] = batch
+		}
+		rs.CopyTo(batch.ResourceSpans().AppendEmpty())
+	}
+
+	var errs error
+	for endpoint, batch := range batches {
+		errs = multierr.Append(errs, e.export(ctx, endpoint, batch))
+	}
+
+	return errs
+}
+
+func (e *traceExporterImp) export(ctx context.Context, endpoint string, td ptrace.Traces) error {
 	exp, err := e.loadBalancer.Exporter(endpoint)
 	if err != nil {
 		return err
@@ -317,6 +317,57 @@ func TestBatchWithTwoTraces(t *testing.T) {
 	assert.Len(t, sink.AllTraces(), 2)
 }
 
+func TestBatchWithTwoTracesByService(t *testing.T) {
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newNopMockTracesExporter(), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingKey = svcRouting
+	lb, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	p.loadBalancer = lb
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	sink := new(consumertest.TracesSink)
+	lb.exporters["endpoint-1"] = newMockTracesExporter(sink.ConsumeTraces)
+
+	first := simpleTraces()
+	first.ResourceSpans().At(0).Resource().Attributes().InsertString("service.name", "svc-1")
+	second := simpleTraceWithID(pcommon.NewTraceID([16]byte{2, 3, 4, 5}))
+	second.ResourceSpans().At(0).Resource().Attributes().InsertString("service.name", "svc-1")
+	batch := ptrace.NewTraces()
+	first.ResourceSpans().MoveAndAppendTo(batch.ResourceSpans())
+	second.ResourceSpans().MoveAndAppendTo(batch.ResourceSpans())
+
+	// test
+	err = p.ConsumeTraces(context.Background(), batch)
+
+	// verify
+	assert.NoError(t, err)
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, 2, sink.SpanCount())
+}
+
+func TestNewTracesExporterUnsupportedRoutingKey(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.RoutingKey = metricRouting
+
+	// test
+	p, err := newTracesExporter(componenttest.NewNopExporterCreateSettings(), cfg)
+
+	// verify
+	assert.Nil(t, p)
+	assert.EqualError(t, err, `unsupported routing_key "metric" for traces, must be one of "traceID" or "service"`)
+}
+
 func TestNoTracesInBatch(t *testing.T) {
 	for _, tt := range []struct {
 		desc  string
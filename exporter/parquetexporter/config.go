@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+const (
+	compressionNone   = "none"
+	compressionSnappy = "snappy"
+	compressionGzip   = "gzip"
+	compressionZstd   = "zstd"
+)
+
+// Config defines configuration for the Parquet exporter.
+type Config struct {
+	config.ExporterSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Path is the directory the Parquet files are written to. It is created if it doesn't exist.
+	Path string `mapstructure:"path"`
+
+	// Compression is the codec used for column chunks. One of "none", "snappy", "gzip" or "zstd".
+	Compression string `mapstructure:"compression"`
+
+	// MaxFileSize is the approximate size in bytes after which the current file is closed
+	// and a new one is started. Zero disables size based rolling.
+	MaxFileSize int64 `mapstructure:"max_file_size"`
+
+	// MaxFileAge is the time after which the current file is closed and a new one is started,
+	// even if it didn't reach MaxFileSize. Zero disables time based rolling.
+	MaxFileAge time.Duration `mapstructure:"max_file_age"`
+
+	// RowGroupSize is the approximate size in bytes of the row groups buffered in memory
+	// before being written to the file.
+	RowGroupSize int64 `mapstructure:"row_group_size"`
+}
+
+var _ config.Exporter = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Path == "" {
+		return errors.New("path must be non-empty")
+	}
+	switch cfg.Compression {
+	case compressionNone, compressionSnappy, compressionGzip, compressionZstd:
+	default:
+		return fmt.Errorf("unsupported compression %q, must be one of %q, %q, %q or %q",
+			cfg.Compression, compressionNone, compressionSnappy, compressionGzip, compressionZstd)
+	}
+	if cfg.MaxFileSize < 0 {
+		return errors.New("max_file_size must not be negative")
+	}
+	if cfg.MaxFileAge < 0 {
+		return errors.New("max_file_age must not be negative")
+	}
+	if cfg.RowGroupSize <= 0 {
+		return errors.New("row_group_size must be positive")
+	}
+	return nil
+}
@@ -24,25 +24,25 @@ import (
 )
 
 type parquetExporter struct {
-	path string
+	writer *rollingWriter
 }
 
-func (e parquetExporter) start(ctx context.Context, host component.Host) error {
-	return nil
+func (e *parquetExporter) start(_ context.Context, _ component.Host) error {
+	return e.writer.start()
 }
 
-func (e parquetExporter) shutdown(ctx context.Context) error {
-	return nil
+func (e *parquetExporter) shutdown(_ context.Context) error {
+	return e.writer.shutdown()
 }
 
-func (e parquetExporter) consumeMetrics(ctx context.Context, ld pmetric.Metrics) error {
-	return nil
+func (e *parquetExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	return e.writer.write(metricsToRows(md))
 }
 
-func (e parquetExporter) consumeTraces(ctx context.Context, ld ptrace.Traces) error {
-	return nil
+func (e *parquetExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	return e.writer.write(tracesToRows(td))
 }
 
-func (e parquetExporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
-	return nil
+func (e *parquetExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	return e.writer.write(logsToRows(ld))
 }
@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func newTestExporter(t *testing.T, prefix string, schema interface{}, modify func(cfg *Config)) (*parquetExporter, string) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Path = t.TempDir()
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	e := &parquetExporter{writer: newRollingWriter(zap.NewNop(), cfg, prefix, schema)}
+	require.NoError(t, e.start(context.Background(), componenttest.NewNopHost()))
+	return e, cfg.Path
+}
+
+func parquetFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+fileExtension))
+	require.NoError(t, err)
+	return files
+}
+
+// readFile reads all rows of the Parquet file at path into rows, which must be a pointer
+// to a slice of the given schema type.
+func readFile(t *testing.T, path string, schema interface{}, rows interface{}) {
+	fr, err := local.NewLocalFileReader(path)
+	require.NoError(t, err)
+	defer fr.Close()
+	pr, err := reader.NewParquetReader(fr, schema, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	slice := reflect.ValueOf(rows).Elem()
+	slice.Set(reflect.MakeSlice(slice.Type(), int(pr.GetNumRows()), int(pr.GetNumRows())))
+	require.NoError(t, pr.Read(rows))
+}
+
+func TestConsumeTraces(t *testing.T) {
+	e, dir := newTestExporter(t, tracesPrefix, new(spanRow), nil)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("service.name", "svc")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	span := ss.Spans().AppendEmpty()
+	span.SetTraceID(pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pcommon.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	span.SetName("operation")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(1000)
+	span.SetEndTimestamp(3000)
+	span.Attributes().InsertInt("http.status_code", 200)
+	span.Status().SetCode(ptrace.StatusCodeError)
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(2000)
+	event.Attributes().InsertString("exception.message", "boom")
+
+	require.NoError(t, e.consumeTraces(context.Background(), td))
+	assert.Empty(t, parquetFiles(t, dir), "file must not be visible before it is closed")
+	require.NoError(t, e.shutdown(context.Background()))
+
+	files := parquetFiles(t, dir)
+	require.Len(t, files, 1)
+	var rows []spanRow
+	readFile(t, files[0], new(spanRow), &rows)
+	require.Len(t, rows, 1)
+	assert.Equal(t, map[string]string{"service.name": "svc"}, rows[0].ResourceAttributes)
+	assert.Equal(t, "scope", rows[0].ScopeName)
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", rows[0].TraceID)
+	assert.Equal(t, "0102030405060708", rows[0].SpanID)
+	assert.Equal(t, "", rows[0].ParentSpanID)
+	assert.Equal(t, "operation", rows[0].Name)
+	assert.Equal(t, "SPAN_KIND_SERVER", rows[0].Kind)
+	assert.Equal(t, int64(2000), rows[0].DurationNano)
+	assert.Equal(t, map[string]string{"http.status_code": "200"}, rows[0].Attributes)
+	assert.Equal(t, "STATUS_CODE_ERROR", rows[0].StatusCode)
+	require.Len(t, rows[0].Events, 1)
+	assert.Equal(t, spanEventRow{
+		TimeUnixNano: 2000,
+		Name:         "exception",
+		Attributes:   map[string]string{"exception.message": "boom"},
+	}, rows[0].Events[0])
+}
+
+func TestConsumeMetrics(t *testing.T) {
+	e, dir := newTestExporter(t, metricsPrefix, new(dataPointRow), func(cfg *Config) {
+		cfg.Compression = compressionZstd
+	})
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("service.name", "svc")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	sum := metrics.AppendEmpty()
+	sum.SetName("requests")
+	sum.SetDataType(pmetric.MetricDataTypeSum)
+	sum.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+	sum.Sum().SetIsMonotonic(true)
+	dp := sum.Sum().DataPoints().AppendEmpty()
+	dp.SetIntVal(42)
+	dp.SetTimestamp(1000)
+	dp.Attributes().InsertString("method", "GET")
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetDataType(pmetric.MetricDataTypeHistogram)
+	histogram.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
+	hdp := histogram.Histogram().DataPoints().AppendEmpty()
+	hdp.SetCount(3)
+	hdp.SetSum(7.5)
+	hdp.SetExplicitBounds(pcommon.NewImmutableFloat64Slice([]float64{1, 5}))
+	hdp.SetBucketCounts(pcommon.NewImmutableUInt64Slice([]uint64{1, 1, 1}))
+
+	summary := metrics.AppendEmpty()
+	summary.SetName("sizes")
+	summary.SetDataType(pmetric.MetricDataTypeSummary)
+	sdp := summary.Summary().DataPoints().AppendEmpty()
+	sdp.SetCount(2)
+	sdp.SetSum(3)
+	qv := sdp.QuantileValues().AppendEmpty()
+	qv.SetQuantile(0.5)
+	qv.SetValue(1.5)
+
+	require.NoError(t, e.consumeMetrics(context.Background(), md))
+	require.NoError(t, e.shutdown(context.Background()))
+
+	files := parquetFiles(t, dir)
+	require.Len(t, files, 1)
+	var rows []dataPointRow
+	readFile(t, files[0], new(dataPointRow), &rows)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, "requests", rows[0].MetricName)
+	assert.Equal(t, "Sum", rows[0].MetricType)
+	assert.Equal(t, "AGGREGATION_TEMPORALITY_CUMULATIVE", *rows[0].AggregationTemporality)
+	assert.True(t, *rows[0].IsMonotonic)
+	assert.Equal(t, int64(42), *rows[0].ValueInt)
+	assert.Nil(t, rows[0].ValueDouble)
+	assert.Equal(t, map[string]string{"method": "GET"}, rows[0].Attributes)
+
+	assert.Equal(t, "latency", rows[1].MetricName)
+	assert.Equal(t, "Histogram", rows[1].MetricType)
+	assert.Nil(t, rows[1].IsMonotonic)
+	assert.Equal(t, int64(3), *rows[1].Count)
+	assert.Equal(t, 7.5, *rows[1].Sum)
+	assert.Equal(t, []float64{1, 5}, rows[1].ExplicitBounds)
+	assert.Equal(t, []int64{1, 1, 1}, rows[1].BucketCounts)
+
+	assert.Equal(t, "sizes", rows[2].MetricName)
+	assert.Equal(t, []quantileValueRow{{Quantile: 0.5, Value: 1.5}}, rows[2].QuantileValues)
+}
+
+func TestConsumeLogs(t *testing.T) {
+	e, dir := newTestExporter(t, logsPrefix, new(logRow), func(cfg *Config) {
+		cfg.Compression = compressionGzip
+	})
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("host.name", "host")
+	logs := rl.ScopeLogs().AppendEmpty().LogRecords()
+	lr := logs.AppendEmpty()
+	lr.SetTimestamp(1000)
+	lr.SetSeverityNumber(plog.SeverityNumberERROR)
+	lr.SetSeverityText("ERROR")
+	lr.Body().SetStringVal("something failed")
+	lr.Attributes().InsertBool("retry", true)
+	structured := logs.AppendEmpty()
+	body := pcommon.NewValueMap()
+	body.MapVal().InsertString("msg", "hello")
+	body.CopyTo(structured.Body())
+
+	require.NoError(t, e.consumeLogs(context.Background(), ld))
+	require.NoError(t, e.shutdown(context.Background()))
+
+	files := parquetFiles(t, dir)
+	require.Len(t, files, 1)
+	var rows []logRow
+	readFile(t, files[0], new(logRow), &rows)
+	require.Len(t, rows, 2)
+	assert.Equal(t, logRow{
+		ResourceAttributes: map[string]string{"host.name": "host"},
+		TimeUnixNano:       1000,
+		SeverityNumber:     int32(plog.SeverityNumberERROR),
+		SeverityText:       "ERROR",
+		Body:               "something failed",
+		Attributes:         map[string]string{"retry": "true"},
+	}, rows[0])
+	assert.Equal(t, `{"msg":"hello"}`, rows[1].Body)
+}
+
+func TestRollOnSize(t *testing.T) {
+	e, dir := newTestExporter(t, logsPrefix, new(logRow), func(cfg *Config) {
+		cfg.MaxFileSize = 1
+	})
+
+	for i := 0; i < 3; i++ {
+		ld := plog.NewLogs()
+		ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("log")
+		require.NoError(t, e.consumeLogs(context.Background(), ld))
+		// File names have nanosecond precision, make sure they're unique even on coarse clocks.
+		time.Sleep(time.Millisecond)
+	}
+	assert.Len(t, parquetFiles(t, dir), 3)
+	require.NoError(t, e.shutdown(context.Background()))
+	assert.Len(t, parquetFiles(t, dir), 3)
+}
+
+func TestRollOnAge(t *testing.T) {
+	e, dir := newTestExporter(t, logsPrefix, new(logRow), func(cfg *Config) {
+		cfg.MaxFileAge = 10 * time.Millisecond
+	})
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("log")
+	require.NoError(t, e.consumeLogs(context.Background(), ld))
+
+	assert.Eventually(t, func() bool {
+		return len(parquetFiles(t, dir)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, e.shutdown(context.Background()))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
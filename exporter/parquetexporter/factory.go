@@ -16,6 +16,7 @@ package parquetexporter // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -27,12 +28,16 @@ const (
 	typeStr = "parquet"
 	// The stability level of the exporter.
 	stability = component.StabilityLevelInDevelopment
-)
 
-type Config struct {
-	config.ExporterSettings `mapstructure:",squash"`
-	Path                    string `mapstructure:"path"`
-}
+	defaultMaxFileSize  = 128 * 1024 * 1024
+	defaultMaxFileAge   = 5 * time.Minute
+	defaultRowGroupSize = 16 * 1024 * 1024
+
+	// File name prefixes of each signal.
+	tracesPrefix  = "traces"
+	metricsPrefix = "metrics"
+	logsPrefix    = "logs"
+)
 
 // NewFactory creates a factory for the Parquet exporter.
 func NewFactory() component.ExporterFactory {
@@ -47,6 +52,10 @@ func NewFactory() component.ExporterFactory {
 func createDefaultConfig() config.Exporter {
 	return &Config{
 		ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+		Compression:      compressionSnappy,
+		MaxFileSize:      defaultMaxFileSize,
+		MaxFileAge:       defaultMaxFileAge,
+		RowGroupSize:     defaultRowGroupSize,
 	}
 }
 
@@ -55,7 +64,7 @@ func createTracesExporter(
 	set component.ExporterCreateSettings,
 	cfg config.Exporter,
 ) (component.TracesExporter, error) {
-	fe := &parquetExporter{path: cfg.(*Config).Path}
+	fe := &parquetExporter{writer: newRollingWriter(set.Logger, cfg.(*Config), tracesPrefix, new(spanRow))}
 	return exporterhelper.NewTracesExporter(
 		cfg,
 		set,
@@ -70,7 +79,7 @@ func createMetricsExporter(
 	set component.ExporterCreateSettings,
 	cfg config.Exporter,
 ) (component.MetricsExporter, error) {
-	fe := &parquetExporter{path: cfg.(*Config).Path}
+	fe := &parquetExporter{writer: newRollingWriter(set.Logger, cfg.(*Config), metricsPrefix, new(dataPointRow))}
 	return exporterhelper.NewMetricsExporter(
 		cfg,
 		set,
@@ -85,7 +94,7 @@ func createLogsExporter(
 	set component.ExporterCreateSettings,
 	cfg config.Exporter,
 ) (component.LogsExporter, error) {
-	fe := &parquetExporter{path: cfg.(*Config).Path}
+	fe := &parquetExporter{writer: newRollingWriter(set.Logger, cfg.(*Config), logsPrefix, new(logRow))}
 	return exporterhelper.NewLogsExporter(
 		cfg,
 		set,
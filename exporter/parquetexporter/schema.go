@@ -0,0 +1,392 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// The structs below define the Parquet schema of each signal. Column names are part of the
+// documented output format (see README.md), so they must not be renamed.
+//
+// Attributes are written as maps of strings; non-string values are rendered the same way
+// as pcommon.Value.AsString does, which means maps and slices are encoded as JSON.
+
+type spanRow struct {
+	ResourceAttributes     map[string]string `parquet:"name=resource_attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ScopeName              string            `parquet:"name=scope_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ScopeVersion           string            `parquet:"name=scope_version, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TraceID                string            `parquet:"name=trace_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	SpanID                 string            `parquet:"name=span_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ParentSpanID           string            `parquet:"name=parent_span_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TraceState             string            `parquet:"name=trace_state, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Name                   string            `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Kind                   string            `parquet:"name=kind, type=BYTE_ARRAY, convertedtype=UTF8"`
+	StartTimeUnixNano      int64             `parquet:"name=start_time_unix_nano, type=INT64"`
+	EndTimeUnixNano        int64             `parquet:"name=end_time_unix_nano, type=INT64"`
+	DurationNano           int64             `parquet:"name=duration_nano, type=INT64"`
+	Attributes             map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	DroppedAttributesCount int32             `parquet:"name=dropped_attributes_count, type=INT32"`
+	Events                 []spanEventRow    `parquet:"name=events, type=LIST"`
+	DroppedEventsCount     int32             `parquet:"name=dropped_events_count, type=INT32"`
+	Links                  []spanLinkRow     `parquet:"name=links, type=LIST"`
+	DroppedLinksCount      int32             `parquet:"name=dropped_links_count, type=INT32"`
+	StatusCode             string            `parquet:"name=status_code, type=BYTE_ARRAY, convertedtype=UTF8"`
+	StatusMessage          string            `parquet:"name=status_message, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+type spanEventRow struct {
+	TimeUnixNano int64             `parquet:"name=time_unix_nano, type=INT64"`
+	Name         string            `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Attributes   map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+}
+
+type spanLinkRow struct {
+	TraceID    string            `parquet:"name=trace_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	SpanID     string            `parquet:"name=span_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TraceState string            `parquet:"name=trace_state, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Attributes map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+}
+
+// dataPointRow holds a single data point of any metric type. Columns that don't apply to the
+// metric type of the data point are left null.
+type dataPointRow struct {
+	ResourceAttributes     map[string]string `parquet:"name=resource_attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ScopeName              string            `parquet:"name=scope_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ScopeVersion           string            `parquet:"name=scope_version, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MetricName             string            `parquet:"name=metric_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MetricDescription      string            `parquet:"name=metric_description, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MetricUnit             string            `parquet:"name=metric_unit, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MetricType             string            `parquet:"name=metric_type, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AggregationTemporality *string           `parquet:"name=aggregation_temporality, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	IsMonotonic            *bool             `parquet:"name=is_monotonic, type=BOOLEAN, repetitiontype=OPTIONAL"`
+	StartTimeUnixNano      int64             `parquet:"name=start_time_unix_nano, type=INT64"`
+	TimeUnixNano           int64             `parquet:"name=time_unix_nano, type=INT64"`
+	Attributes             map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	Flags                  int32             `parquet:"name=flags, type=INT32"`
+
+	// Gauge and Sum.
+	ValueInt    *int64   `parquet:"name=value_int, type=INT64, repetitiontype=OPTIONAL"`
+	ValueDouble *float64 `parquet:"name=value_double, type=DOUBLE, repetitiontype=OPTIONAL"`
+
+	// Histogram, ExponentialHistogram and Summary.
+	Count *int64   `parquet:"name=count, type=INT64, repetitiontype=OPTIONAL"`
+	Sum   *float64 `parquet:"name=sum, type=DOUBLE, repetitiontype=OPTIONAL"`
+	Min   *float64 `parquet:"name=min, type=DOUBLE, repetitiontype=OPTIONAL"`
+	Max   *float64 `parquet:"name=max, type=DOUBLE, repetitiontype=OPTIONAL"`
+
+	// Histogram.
+	BucketCounts   []int64   `parquet:"name=bucket_counts, type=LIST, valuetype=INT64"`
+	ExplicitBounds []float64 `parquet:"name=explicit_bounds, type=LIST, valuetype=DOUBLE"`
+
+	// ExponentialHistogram.
+	Scale                *int32  `parquet:"name=scale, type=INT32, repetitiontype=OPTIONAL"`
+	ZeroCount            *int64  `parquet:"name=zero_count, type=INT64, repetitiontype=OPTIONAL"`
+	PositiveOffset       *int32  `parquet:"name=positive_offset, type=INT32, repetitiontype=OPTIONAL"`
+	PositiveBucketCounts []int64 `parquet:"name=positive_bucket_counts, type=LIST, valuetype=INT64"`
+	NegativeOffset       *int32  `parquet:"name=negative_offset, type=INT32, repetitiontype=OPTIONAL"`
+	NegativeBucketCounts []int64 `parquet:"name=negative_bucket_counts, type=LIST, valuetype=INT64"`
+
+	// Summary.
+	QuantileValues []quantileValueRow `parquet:"name=quantile_values, type=LIST"`
+}
+
+type quantileValueRow struct {
+	Quantile float64 `parquet:"name=quantile, type=DOUBLE"`
+	Value    float64 `parquet:"name=value, type=DOUBLE"`
+}
+
+type logRow struct {
+	ResourceAttributes     map[string]string `parquet:"name=resource_attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ScopeName              string            `parquet:"name=scope_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ScopeVersion           string            `parquet:"name=scope_version, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TimeUnixNano           int64             `parquet:"name=time_unix_nano, type=INT64"`
+	ObservedTimeUnixNano   int64             `parquet:"name=observed_time_unix_nano, type=INT64"`
+	SeverityNumber         int32             `parquet:"name=severity_number, type=INT32"`
+	SeverityText           string            `parquet:"name=severity_text, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Body                   string            `parquet:"name=body, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Attributes             map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	DroppedAttributesCount int32             `parquet:"name=dropped_attributes_count, type=INT32"`
+	TraceID                string            `parquet:"name=trace_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	SpanID                 string            `parquet:"name=span_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Flags                  int32             `parquet:"name=flags, type=INT32"`
+}
+
+func attributesToMap(attrs pcommon.Map) map[string]string {
+	m := make(map[string]string, attrs.Len())
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		m[k] = v.AsString()
+		return true
+	})
+	return m
+}
+
+func traceIDToString(id pcommon.TraceID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.HexString()
+}
+
+func spanIDToString(id pcommon.SpanID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.HexString()
+}
+
+func tracesToRows(td ptrace.Traces) []interface{} {
+	rows := make([]interface{}, 0, td.SpanCount())
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resourceAttrs := attributesToMap(rs.Resource().Attributes())
+		ilss := rs.ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			ils := ilss.At(j)
+			spans := ils.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				rows = append(rows, spanRow{
+					ResourceAttributes:     resourceAttrs,
+					ScopeName:              ils.Scope().Name(),
+					ScopeVersion:           ils.Scope().Version(),
+					TraceID:                traceIDToString(span.TraceID()),
+					SpanID:                 spanIDToString(span.SpanID()),
+					ParentSpanID:           spanIDToString(span.ParentSpanID()),
+					TraceState:             string(span.TraceState()),
+					Name:                   span.Name(),
+					Kind:                   span.Kind().String(),
+					StartTimeUnixNano:      int64(span.StartTimestamp()),
+					EndTimeUnixNano:        int64(span.EndTimestamp()),
+					DurationNano:           int64(span.EndTimestamp()) - int64(span.StartTimestamp()),
+					Attributes:             attributesToMap(span.Attributes()),
+					DroppedAttributesCount: int32(span.DroppedAttributesCount()),
+					Events:                 spanEventsToRows(span.Events()),
+					DroppedEventsCount:     int32(span.DroppedEventsCount()),
+					Links:                  spanLinksToRows(span.Links()),
+					DroppedLinksCount:      int32(span.DroppedLinksCount()),
+					StatusCode:             span.Status().Code().String(),
+					StatusMessage:          span.Status().Message(),
+				})
+			}
+		}
+	}
+	return rows
+}
+
+func spanEventsToRows(events ptrace.SpanEventSlice) []spanEventRow {
+	rows := make([]spanEventRow, 0, events.Len())
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		rows = append(rows, spanEventRow{
+			TimeUnixNano: int64(event.Timestamp()),
+			Name:         event.Name(),
+			Attributes:   attributesToMap(event.Attributes()),
+		})
+	}
+	return rows
+}
+
+func spanLinksToRows(links ptrace.SpanLinkSlice) []spanLinkRow {
+	rows := make([]spanLinkRow, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		rows = append(rows, spanLinkRow{
+			TraceID:    traceIDToString(link.TraceID()),
+			SpanID:     spanIDToString(link.SpanID()),
+			TraceState: string(link.TraceState()),
+			Attributes: attributesToMap(link.Attributes()),
+		})
+	}
+	return rows
+}
+
+func metricsToRows(md pmetric.Metrics) []interface{} {
+	rows := make([]interface{}, 0, md.DataPointCount())
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resourceAttrs := attributesToMap(rm.Resource().Attributes())
+		ilms := rm.ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ilm := ilms.At(j)
+			metrics := ilm.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				base := dataPointRow{
+					ResourceAttributes: resourceAttrs,
+					ScopeName:          ilm.Scope().Name(),
+					ScopeVersion:       ilm.Scope().Version(),
+					MetricName:         metric.Name(),
+					MetricDescription:  metric.Description(),
+					MetricUnit:         metric.Unit(),
+					MetricType:         metric.DataType().String(),
+				}
+				rows = appendDataPointRows(rows, base, metric)
+			}
+		}
+	}
+	return rows
+}
+
+func appendDataPointRows(rows []interface{}, base dataPointRow, metric pmetric.Metric) []interface{} {
+	switch metric.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		rows = appendNumberDataPointRows(rows, base, metric.Gauge().DataPoints())
+	case pmetric.MetricDataTypeSum:
+		base.AggregationTemporality = stringPtr(metric.Sum().AggregationTemporality().String())
+		base.IsMonotonic = boolPtr(metric.Sum().IsMonotonic())
+		rows = appendNumberDataPointRows(rows, base, metric.Sum().DataPoints())
+	case pmetric.MetricDataTypeHistogram:
+		base.AggregationTemporality = stringPtr(metric.Histogram().AggregationTemporality().String())
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := base
+			setDataPointCommon(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			row.Count = int64Ptr(int64(dp.Count()))
+			if dp.HasSum() {
+				row.Sum = float64Ptr(dp.Sum())
+			}
+			if dp.HasMin() {
+				row.Min = float64Ptr(dp.Min())
+			}
+			if dp.HasMax() {
+				row.Max = float64Ptr(dp.Max())
+			}
+			row.BucketCounts = uint64sToInt64s(dp.BucketCounts().AsRaw())
+			row.ExplicitBounds = append([]float64{}, dp.ExplicitBounds().AsRaw()...)
+			rows = append(rows, row)
+		}
+	case pmetric.MetricDataTypeExponentialHistogram:
+		base.AggregationTemporality = stringPtr(metric.ExponentialHistogram().AggregationTemporality().String())
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := base
+			setDataPointCommon(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			row.Count = int64Ptr(int64(dp.Count()))
+			if dp.HasSum() {
+				row.Sum = float64Ptr(dp.Sum())
+			}
+			if dp.HasMin() {
+				row.Min = float64Ptr(dp.Min())
+			}
+			if dp.HasMax() {
+				row.Max = float64Ptr(dp.Max())
+			}
+			row.Scale = int32Ptr(dp.Scale())
+			row.ZeroCount = int64Ptr(int64(dp.ZeroCount()))
+			row.PositiveOffset = int32Ptr(dp.Positive().Offset())
+			row.PositiveBucketCounts = uint64sToInt64s(dp.Positive().BucketCounts().AsRaw())
+			row.NegativeOffset = int32Ptr(dp.Negative().Offset())
+			row.NegativeBucketCounts = uint64sToInt64s(dp.Negative().BucketCounts().AsRaw())
+			rows = append(rows, row)
+		}
+	case pmetric.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := base
+			setDataPointCommon(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			row.Count = int64Ptr(int64(dp.Count()))
+			row.Sum = float64Ptr(dp.Sum())
+			qvs := dp.QuantileValues()
+			row.QuantileValues = make([]quantileValueRow, 0, qvs.Len())
+			for j := 0; j < qvs.Len(); j++ {
+				row.QuantileValues = append(row.QuantileValues, quantileValueRow{
+					Quantile: qvs.At(j).Quantile(),
+					Value:    qvs.At(j).Value(),
+				})
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func appendNumberDataPointRows(rows []interface{}, base dataPointRow, dps pmetric.NumberDataPointSlice) []interface{} {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		row := base
+		setDataPointCommon(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			row.ValueInt = int64Ptr(dp.IntVal())
+		case pmetric.NumberDataPointValueTypeDouble:
+			row.ValueDouble = float64Ptr(dp.DoubleVal())
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func setDataPointCommon(row *dataPointRow, start, ts pcommon.Timestamp, attrs pcommon.Map, flags pmetric.MetricDataPointFlags) {
+	row.StartTimeUnixNano = int64(start)
+	row.TimeUnixNano = int64(ts)
+	row.Attributes = attributesToMap(attrs)
+	row.Flags = int32(flags)
+}
+
+func logsToRows(ld plog.Logs) []interface{} {
+	rows := make([]interface{}, 0, ld.LogRecordCount())
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resourceAttrs := attributesToMap(rl.Resource().Attributes())
+		slls := rl.ScopeLogs()
+		for j := 0; j < slls.Len(); j++ {
+			sl := slls.At(j)
+			logs := sl.LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				lr := logs.At(k)
+				rows = append(rows, logRow{
+					ResourceAttributes:     resourceAttrs,
+					ScopeName:              sl.Scope().Name(),
+					ScopeVersion:           sl.Scope().Version(),
+					TimeUnixNano:           int64(lr.Timestamp()),
+					ObservedTimeUnixNano:   int64(lr.ObservedTimestamp()),
+					SeverityNumber:         int32(lr.SeverityNumber()),
+					SeverityText:           lr.SeverityText(),
+					Body:                   lr.Body().AsString(),
+					Attributes:             attributesToMap(lr.Attributes()),
+					DroppedAttributesCount: int32(lr.DroppedAttributesCount()),
+					TraceID:                traceIDToString(lr.TraceID()),
+					SpanID:                 spanIDToString(lr.SpanID()),
+					Flags:                  int32(lr.Flags()),
+				})
+			}
+		}
+	}
+	return rows
+}
+
+func uint64sToInt64s(values []uint64) []int64 {
+	res := make([]int64, len(values))
+	for i, v := range values {
+		res[i] = int64(v)
+	}
+	return res
+}
+
+func stringPtr(v string) *string    { return &v }
+func boolPtr(v bool) *bool          { return &v }
+func int32Ptr(v int32) *int32       { return &v }
+func int64Ptr(v int64) *int64       { return &v }
+func float64Ptr(v float64) *float64 { return &v }
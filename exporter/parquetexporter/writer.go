@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	fileExtension = ".parquet"
+	// inProgressSuffix is appended to the name of the file being written, so that readers
+	// never pick up a file that doesn't have its footer yet.
+	inProgressSuffix = ".tmp"
+	// fileTimeFormat is used to build unique and sortable file names.
+	fileTimeFormat = "20060102T150405.000000000Z"
+)
+
+var compressionCodecs = map[string]parquet.CompressionCodec{
+	compressionNone:   parquet.CompressionCodec_UNCOMPRESSED,
+	compressionSnappy: parquet.CompressionCodec_SNAPPY,
+	compressionGzip:   parquet.CompressionCodec_GZIP,
+	compressionZstd:   parquet.CompressionCodec_ZSTD,
+}
+
+// rollingWriter writes rows of a single schema to Parquet files in a directory, starting
+// a new file once the current one exceeds the configured size or age.
+type rollingWriter struct {
+	logger       *zap.Logger
+	dir          string
+	prefix       string
+	schema       interface{}
+	compression  parquet.CompressionCodec
+	maxFileSize  int64
+	maxFileAge   time.Duration
+	rowGroupSize int64
+	now          func() time.Time
+
+	mutex    sync.Mutex
+	file     *os.File
+	pw       *writer.ParquetWriter
+	openedAt time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newRollingWriter(logger *zap.Logger, cfg *Config, prefix string, schema interface{}) *rollingWriter {
+	return &rollingWriter{
+		logger:       logger,
+		dir:          cfg.Path,
+		prefix:       prefix,
+		schema:       schema,
+		compression:  compressionCodecs[cfg.Compression],
+		maxFileSize:  cfg.MaxFileSize,
+		maxFileAge:   cfg.MaxFileAge,
+		rowGroupSize: cfg.RowGroupSize,
+		now:          time.Now,
+		done:         make(chan struct{}),
+	}
+}
+
+func (w *rollingWriter) start() error {
+	if err := os.MkdirAll(w.dir, 0700); err != nil {
+		return err
+	}
+	if w.maxFileAge > 0 {
+		w.wg.Add(1)
+		go w.rollOnAge()
+	}
+	return nil
+}
+
+// rollOnAge closes files that reached their maximum age even if nothing is written to them anymore.
+func (w *rollingWriter) rollOnAge() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.maxFileAge / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.mutex.Lock()
+			if w.pw != nil && w.now().Sub(w.openedAt) >= w.maxFileAge {
+				if err := w.closeFile(); err != nil {
+					w.logger.Error("Failed to close Parquet file", zap.Error(err))
+				}
+			}
+			w.mutex.Unlock()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *rollingWriter) write(rows []interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.pw != nil && w.maxFileAge > 0 && w.now().Sub(w.openedAt) >= w.maxFileAge {
+		if err := w.closeFile(); err != nil {
+			return err
+		}
+	}
+	if w.pw == nil {
+		if err := w.openFile(); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows {
+		if err := w.pw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	if w.maxFileSize > 0 && w.size() >= w.maxFileSize {
+		return w.closeFile()
+	}
+	return nil
+}
+
+// size returns the approximate size of the current file, including the buffered data.
+func (w *rollingWriter) size() int64 {
+	return w.pw.Offset + w.pw.Size + w.pw.ObjsSize
+}
+
+func (w *rollingWriter) openFile() error {
+	name := fmt.Sprintf("%s-%s%s", w.prefix, w.now().UTC().Format(fileTimeFormat), fileExtension)
+	file, err := os.OpenFile(filepath.Join(w.dir, name+inProgressSuffix), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	pw, err := writer.NewParquetWriterFromWriter(file, w.schema, 1)
+	if err != nil {
+		return multierr.Append(err, file.Close())
+	}
+	pw.CompressionType = w.compression
+	pw.RowGroupSize = w.rowGroupSize
+
+	w.file = file
+	w.pw = pw
+	w.openedAt = w.now()
+	return nil
+}
+
+// closeFile writes the footer of the current file and moves it to its final name.
+func (w *rollingWriter) closeFile() error {
+	err := w.pw.WriteStop()
+	err = multierr.Append(err, w.file.Close())
+	if err == nil {
+		tmpName := w.file.Name()
+		err = os.Rename(tmpName, tmpName[:len(tmpName)-len(inProgressSuffix)])
+	}
+	w.file = nil
+	w.pw = nil
+	return err
+}
+
+func (w *rollingWriter) shutdown() error {
+	close(w.done)
+	w.wg.Wait()
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.pw == nil {
+		return nil
+	}
+	return w.closeFile()
+}
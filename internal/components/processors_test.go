@@ -62,6 +62,9 @@ func TestDefaultProcessors(t *testing.T) {
 		{
 			processor: "batch",
 		},
+		{
+			processor: "deltatocumulative",
+		},
 		{
 			processor: "deltatorate",
 		},
@@ -36,6 +36,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tcplogreceiver"
@@ -205,6 +206,18 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "otlp",
 		},
+		{
+			receiver: "otlpjsonfile",
+			getConfigFn: func() config.Receiver {
+				cfg := rcvrFactories["otlpjsonfile"].CreateDefaultConfig().(*otlpjsonfilereceiver.Config)
+				cfg.Input = adapter.InputConfig{
+					"include": []string{
+						filepath.Join(t.TempDir(), "*"),
+					},
+				}
+				return cfg
+			},
+		},
 		{
 			receiver:     "podman_stats",
 			skipLifecyle: true, // Requires a running podman daemon
@@ -228,6 +241,9 @@ func TestDefaultReceivers(t *testing.T) {
 			receiver:     "prometheus_exec",
 			skipLifecyle: true, // Requires running a subproccess that can not be easily set across platforms
 		},
+		{
+			receiver: "prometheusremotewrite",
+		},
 		{
 			receiver: "rabbitmq",
 		},
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"math"
+	"os"
+)
+
+const (
+	noCompression   = ""
+	gzipCompression = "gzip"
+	autoCompression = "auto"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// isCompressed returns true if the file should be read through a gzip decompressor
+func (f *Input) isCompressed(file *os.File) bool {
+	switch f.compression {
+	case gzipCompression:
+		return true
+	case autoCompression:
+		buf := make([]byte, len(gzipMagic))
+		n, _ := file.ReadAt(buf, 0)
+		return bytes.Equal(buf[:n], gzipMagic)
+	default:
+		return false
+	}
+}
+
+// gzipSource decompresses a gzip file from its beginning. The underlying
+// file is read through a section reader so that the file offset is untouched.
+type gzipSource struct {
+	*gzip.Reader
+
+	// eof is set once the whole stream has been decompressed and its checksum verified
+	eof bool
+}
+
+func newGzipSource(file *os.File) (*gzipSource, error) {
+	gz, err := gzip.NewReader(io.NewSectionReader(file, 0, math.MaxInt64))
+	if err != nil {
+		return nil, err
+	}
+	return &gzipSource{Reader: gz}, nil
+}
+
+func (s *gzipSource) Read(dst []byte) (int, error) {
+	n, err := s.Reader.Read(dst)
+	if errors.Is(err, io.EOF) {
+		s.eof = true
+	}
+	return n, err
+}
@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func gzipBytes(t testing.TB, s string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func writeGzipFile(t testing.TB, path, s string) {
+	require.NoError(t, os.WriteFile(path, gzipBytes(t, s), 0600))
+}
+
+func TestReadGzipFile(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.Compression = "gzip"
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	// The last line does not need to be terminated
+	writeGzipFile(t, filepath.Join(tempDir, "archive.log.gz"), "testlog1\ntestlog2")
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+	require.Len(t, operator.knownFiles, 1)
+	require.True(t, operator.knownFiles[0].Completed)
+
+	// A completed file is not read again
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+}
+
+func TestReadGzipFileAutoDetect(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.Compression = "auto"
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	writeGzipFile(t, filepath.Join(tempDir, "archive"), "compressed\n")
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "plain\n")
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("compressed"), []byte("plain")})
+
+	// The plaintext file is still followed
+	writeString(t, temp, "plain2\n")
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("plain2"))
+}
+
+func TestReadGzipFileTruncated(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.Compression = "gzip"
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	// Compress incompressible content so that the stream can be cut mid-way
+	content := string(tokenWithLength(100)) + "\n" + string(tokenWithLength(2000)) + "\n" + "testlog3"
+	compressed := gzipBytes(t, content)
+	path := filepath.Join(tempDir, "archive.log.gz")
+	require.NoError(t, os.WriteFile(path, compressed[:len(compressed)/2], 0600))
+
+	operator.poll(context.Background())
+	require.Equal(t, content[:100], string(waitForEmit(t, emitCalls).token))
+	expectNoTokens(t, emitCalls)
+	require.False(t, operator.knownFiles[0].Completed)
+
+	require.NoError(t, os.WriteFile(path, compressed, 0600))
+	operator.poll(context.Background())
+	require.Equal(t, content[101:2101], string(waitForEmit(t, emitCalls).token))
+	waitForToken(t, emitCalls, []byte("testlog3"))
+	require.True(t, operator.knownFiles[len(operator.knownFiles)-1].Completed)
+}
+
+func TestReadGzipFileAfterRestart(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.Compression = "gzip"
+	})
+	persister := testutil.NewMockPersister("test")
+
+	writeGzipFile(t, filepath.Join(tempDir, "archive.log.gz"), "testlog1\ntestlog2\n")
+
+	require.NoError(t, operator.Start(persister))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+
+	// Completion is persisted, so the file is not read again
+	require.NoError(t, operator.Stop())
+	require.NoError(t, operator.Start(persister))
+	expectNoTokensUntil(t, emitCalls, time.Second)
+}
+
+func TestReadGzipFileStartAtEnd(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.StartAt = "end"
+		cfg.Compression = "gzip"
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	writeGzipFile(t, filepath.Join(tempDir, "archive.log.gz"), "testlog1\n")
+
+	// Files that exist on the first poll are skipped entirely
+	operator.poll(context.Background())
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+
+	// Files that appear later are read from the beginning
+	writeGzipFile(t, filepath.Join(tempDir, "archive.log.1.gz"), "testlog2\n")
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog2"))
+}
+
+// TestReadGzipFileRotated tests that a file which is compressed after
+// rotation is recognized, and only its unread content is emitted
+func TestReadGzipFileRotated(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.Compression = "auto"
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "app.log")
+	require.NoError(t, os.WriteFile(path, []byte("testlog1\ntestlog2\n"), 0600))
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+
+	writeGzipFile(t, path+".1.gz", "testlog1\ntestlog2\ntestlog3\n")
+	require.NoError(t, os.Remove(path))
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog3"))
+	expectNoTokens(t, emitCalls)
+}
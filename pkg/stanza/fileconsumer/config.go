@@ -57,6 +57,9 @@ type Config struct {
 	FingerprintSize         helper.ByteSize       `mapstructure:"fingerprint_size,omitempty"               json:"fingerprint_size,omitempty"              yaml:"fingerprint_size,omitempty"`
 	MaxLogSize              helper.ByteSize       `mapstructure:"max_log_size,omitempty"                   json:"max_log_size,omitempty"                  yaml:"max_log_size,omitempty"`
 	MaxConcurrentFiles      int                   `mapstructure:"max_concurrent_files,omitempty"           json:"max_concurrent_files,omitempty"          yaml:"max_concurrent_files,omitempty"`
+	Compression             string                `mapstructure:"compression,omitempty"                    json:"compression,omitempty"                   yaml:"compression,omitempty"`
+	OrderingCriteria        OrderingCriteria      `mapstructure:"ordering_criteria,omitempty"              json:"ordering_criteria,omitempty"             yaml:"ordering_criteria,omitempty"`
+	DeleteAfterRead         bool                  `mapstructure:"delete_after_read,omitempty"              json:"delete_after_read,omitempty"             yaml:"delete_after_read,omitempty"`
 	Splitter                helper.SplitterConfig `mapstructure:",squash,omitempty"                        json:",inline,omitempty"                       yaml:",inline,omitempty"`
 }
 
@@ -116,11 +119,30 @@ func (c Config) Build(logger *zap.SugaredLogger, emit EmitFunc) (*Input, error)
 		return nil, fmt.Errorf("invalid start_at location '%s'", c.StartAt)
 	}
 
+	// Files must be read from the beginning, since they are only seen once
+	if c.DeleteAfterRead && !startAtBeginning {
+		return nil, fmt.Errorf("`delete_after_read` cannot be used with `start_at: %s`", c.StartAt)
+	}
+
+	switch c.Compression {
+	case noCompression, gzipCompression, autoCompression:
+	default:
+		return nil, fmt.Errorf("invalid compression '%s'", c.Compression)
+	}
+
+	orderer, err := c.OrderingCriteria.build()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Input{
 		SugaredLogger:      logger.With("component", "fileconsumer"),
 		finder:             c.Finder,
+		orderer:            orderer,
 		PollInterval:       c.PollInterval.Raw(),
 		startAtBeginning:   startAtBeginning,
+		compression:        c.Compression,
+		deleteAfterRead:    c.DeleteAfterRead,
 		SplitterConfig:     c.Splitter,
 		queuedMatches:      make([]string, 0),
 		firstCheck:         true,
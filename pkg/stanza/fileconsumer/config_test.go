@@ -320,6 +320,45 @@ func TestUnmarshal(t *testing.T) {
 				return cfg
 			}(),
 		},
+		{
+			Name:      "compression_gzip",
+			ExpectErr: false,
+			Expect: func() *Config {
+				cfg := NewConfig()
+				cfg.Compression = "gzip"
+				return cfg
+			}(),
+		},
+		{
+			Name:      "ordering_criteria",
+			ExpectErr: false,
+			Expect: func() *Config {
+				cfg := NewConfig()
+				cfg.OrderingCriteria = OrderingCriteria{
+					Regex: `^app-(?P<date>\d{8})\.log$`,
+					TopN:  2,
+					SortBy: []SortRule{
+						{
+							RegexKey: "date",
+							SortType: "timestamp",
+							Layout:   "%Y%m%d",
+							Location: "UTC",
+						},
+					},
+				}
+				return cfg
+			}(),
+		},
+		{
+			Name:      "delete_after_read",
+			ExpectErr: false,
+			Expect: func() *Config {
+				cfg := NewConfig()
+				cfg.DeleteAfterRead = true
+				cfg.StartAt = "beginning"
+				return cfg
+			}(),
+		},
 		{
 			Name:      "max_concurrent_large",
 			ExpectErr: false,
@@ -434,6 +473,68 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"GzipCompression",
+			func(f *Config) {
+				f.Compression = "gzip"
+			},
+			require.NoError,
+			func(t *testing.T, f *Input) {
+				require.Equal(t, "gzip", f.compression)
+			},
+		},
+		{
+			"InvalidCompression",
+			func(f *Config) {
+				f.Compression = "zip"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"OrderingCriteria",
+			func(f *Config) {
+				f.OrderingCriteria = OrderingCriteria{
+					Regex:  `app\.(?P<num>\d+)\.log`,
+					SortBy: []SortRule{{RegexKey: "num", SortType: "numeric"}},
+				}
+			},
+			require.NoError,
+			func(t *testing.T, f *Input) {
+				require.NotNil(t, f.orderer)
+				require.Equal(t, 1, f.orderer.topN)
+			},
+		},
+		{
+			"InvalidOrderingCriteria",
+			func(f *Config) {
+				f.OrderingCriteria = OrderingCriteria{
+					SortBy: []SortRule{{RegexKey: "num", SortType: "numeric"}},
+				}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"DeleteAfterRead",
+			func(f *Config) {
+				f.DeleteAfterRead = true
+				f.StartAt = "beginning"
+			},
+			require.NoError,
+			func(t *testing.T, f *Input) {
+				require.True(t, f.deleteAfterRead)
+			},
+		},
+		{
+			"DeleteAfterReadStartAtEnd",
+			func(f *Config) {
+				f.DeleteAfterRead = true
+				f.StartAt = "end"
+			},
+			require.Error,
+			nil,
+		},
 		{
 			"MultilineConfiguredStartAndEndPatterns",
 			func(f *Config) {
@@ -35,6 +35,7 @@ type EmitFunc func(ctx context.Context, attrs *FileAttributes, token []byte)
 type Input struct {
 	*zap.SugaredLogger
 	finder             Finder
+	orderer            *orderer
 	PollInterval       time.Duration
 	SplitterConfig     helper.SplitterConfig
 	MaxLogSize         int
@@ -49,6 +50,8 @@ type Input struct {
 	roller        roller
 
 	startAtBeginning bool
+	compression      string
+	deleteAfterRead  bool
 
 	fingerprintSize int
 
@@ -129,6 +132,9 @@ func (f *Input) poll(ctx context.Context) {
 
 			// Get the list of paths on disk
 			matches = f.finder.FindFiles()
+			if f.orderer != nil {
+				matches = f.orderer.apply(matches)
+			}
 			if f.firstCheck && len(matches) == 0 {
 				f.Warnw("no files match the configured include patterns",
 					"include", f.finder.Include,
@@ -157,6 +163,10 @@ func (f *Input) poll(ctx context.Context) {
 	}
 	wg.Wait()
 
+	if f.deleteAfterRead {
+		readers = forgetDeleted(readers)
+	}
+
 	f.roller.roll(ctx, readers)
 	f.saveCurrent(readers)
 	f.syncLastPollFiles(ctx)
@@ -238,6 +248,18 @@ OUTER:
 	return readers
 }
 
+// forgetDeleted removes the readers of deleted files, so that a new file
+// starting with the same content is not mistaken for one already read
+func forgetDeleted(readers []*Reader) []*Reader {
+	kept := readers[:0]
+	for _, reader := range readers {
+		if !reader.deleted {
+			kept = append(kept, reader)
+		}
+	}
+	return kept
+}
+
 // saveCurrent adds the readers from this polling interval to this list of
 // known files, then increments the generation of all tracked old readers
 // before clearing out readers that have existed for 3 generations.
@@ -359,7 +381,9 @@ func (f *Input) loadLastPollFiles(ctx context.Context) error {
 	return nil
 }
 
-// getMultiline returns helper.Splitter structure and error eventually
+// getMultiline returns helper.Splitter structure and error eventually.
+// Files which are deleted after being read are expected to be complete,
+// so their last log is flushed without waiting for more content.
 func (f *Input) getMultiline() (*helper.Splitter, error) {
-	return f.SplitterConfig.Build(false, f.MaxLogSize)
+	return f.SplitterConfig.Build(f.deleteAfterRead, f.MaxLogSize)
 }
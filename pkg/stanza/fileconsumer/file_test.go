@@ -1085,3 +1085,68 @@ func TestEncodings(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteAfterRead(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.DeleteAfterRead = true
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	// The last line of a file does not need to be terminated
+	path1 := filepath.Join(tempDir, "file1.log")
+	path2 := filepath.Join(tempDir, "file2.log")
+	require.NoError(t, ioutil.WriteFile(path1, []byte("testlog1\ntestlog2\n"), 0600))
+	require.NoError(t, ioutil.WriteFile(path2, []byte("testlog3\ntestlog4"), 0600))
+
+	operator.poll(context.Background())
+	tokens := waitForNTokens(t, emitCalls, 4)
+	require.ElementsMatch(t, [][]byte{[]byte("testlog1"), []byte("testlog2"), []byte("testlog3"), []byte("testlog4")}, tokens)
+
+	require.NoFileExists(t, path1)
+	require.NoFileExists(t, path2)
+	require.Empty(t, operator.knownFiles)
+}
+
+// TestDeleteAfterReadSameContent tests that a file is read in full
+// even if its content is the same as a file which was deleted
+func TestDeleteAfterReadSameContent(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.DeleteAfterRead = true
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "file.log")
+	for i := 0; i < 2; i++ {
+		require.NoError(t, ioutil.WriteFile(path, []byte("testlog1\ntestlog2\n"), 0600))
+		operator.poll(context.Background())
+		waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+		require.NoFileExists(t, path)
+	}
+}
+
+func TestDeleteAfterReadGzip(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.DeleteAfterRead = true
+		cfg.Compression = "gzip"
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "file.log.gz")
+	writeGzipFile(t, path, "testlog1\ntestlog2\n")
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+	require.NoFileExists(t, path)
+}
@@ -34,6 +34,10 @@ type Fingerprint struct {
 
 // NewFingerprint creates a new fingerprint from an open file
 func (f *Input) NewFingerprint(file *os.File) (*Fingerprint, error) {
+	if f.isCompressed(file) {
+		return f.newCompressedFingerprint(file)
+	}
+
 	buf := make([]byte, f.fingerprintSize)
 
 	n, err := file.ReadAt(buf, 0)
@@ -48,6 +52,27 @@ func (f *Input) NewFingerprint(file *os.File) (*Fingerprint, error) {
 	return fp, nil
 }
 
+// newCompressedFingerprint creates a fingerprint from the first decompressed
+// bytes of a gzip file, so that a file is recognized after being compressed
+func (f *Input) newCompressedFingerprint(file *os.File) (*Fingerprint, error) {
+	src, err := newGzipSource(file)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		// The gzip header has not been fully written yet
+		return &Fingerprint{FirstBytes: []byte{}}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("reading gzip header: %w", err)
+	}
+	defer src.Close()
+
+	buf := make([]byte, f.fingerprintSize)
+	n, err := io.ReadFull(src, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("reading fingerprint bytes: %w", err)
+	}
+
+	return &Fingerprint{FirstBytes: buf[:n]}, nil
+}
+
 // Copy creates a new copy of the fingerprint
 func (f Fingerprint) Copy() *Fingerprint {
 	buf := make([]byte, len(f.FirstBytes), cap(f.FirstBytes))
@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	strptime "github.com/observiq/ctimefmt"
+)
+
+const (
+	sortTypeNumeric      = "numeric"
+	sortTypeAlphabetical = "alphabetical"
+	sortTypeTimestamp    = "timestamp"
+	sortTypeMtime        = "mtime"
+
+	defaultOrderingTopN = 1
+)
+
+// OrderingCriteria selects the files to read among the ones matched by the finder
+type OrderingCriteria struct {
+	Regex  string     `mapstructure:"regex,omitempty"   json:"regex,omitempty"   yaml:"regex,omitempty"`
+	TopN   int        `mapstructure:"top_n,omitempty"   json:"top_n,omitempty"   yaml:"top_n,omitempty"`
+	SortBy []SortRule `mapstructure:"sort_by,omitempty" json:"sort_by,omitempty" yaml:"sort_by,omitempty"`
+}
+
+// SortRule describes how to compare files. The value to compare is either captured
+// from the file name by a named group of the regex, or the modification time of the file.
+type SortRule struct {
+	RegexKey  string `mapstructure:"regex_key,omitempty" json:"regex_key,omitempty" yaml:"regex_key,omitempty"`
+	SortType  string `mapstructure:"sort_type,omitempty" json:"sort_type,omitempty" yaml:"sort_type,omitempty"`
+	Layout    string `mapstructure:"layout,omitempty"    json:"layout,omitempty"    yaml:"layout,omitempty"`
+	Location  string `mapstructure:"location,omitempty"  json:"location,omitempty"  yaml:"location,omitempty"`
+	Ascending bool   `mapstructure:"ascending,omitempty" json:"ascending,omitempty" yaml:"ascending,omitempty"`
+}
+
+// orderer sorts files according to validated ordering criteria
+type orderer struct {
+	regex *regexp.Regexp
+	topN  int
+	rules []sortRule
+}
+
+type sortRule struct {
+	SortRule
+	group    int
+	layout   string
+	location *time.Location
+}
+
+// build validates the ordering criteria. It returns nil if no sorting is configured.
+func (c OrderingCriteria) build() (*orderer, error) {
+	if len(c.SortBy) == 0 {
+		if c.Regex != "" || c.TopN != 0 {
+			return nil, fmt.Errorf("`ordering_criteria.sort_by` is required")
+		}
+		return nil, nil
+	}
+
+	o := &orderer{topN: c.TopN}
+	if o.topN == 0 {
+		o.topN = defaultOrderingTopN
+	} else if o.topN < 0 {
+		return nil, fmt.Errorf("`ordering_criteria.top_n` must be positive")
+	}
+
+	if c.Regex != "" {
+		regex, err := regexp.Compile(c.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("compile `ordering_criteria.regex`: %w", err)
+		}
+		o.regex = regex
+	}
+
+	for _, rule := range c.SortBy {
+		r := sortRule{SortRule: rule}
+		switch rule.SortType {
+		case sortTypeMtime:
+			o.rules = append(o.rules, r)
+			continue
+		case sortTypeNumeric, sortTypeAlphabetical:
+		case sortTypeTimestamp:
+			if rule.Layout == "" {
+				return nil, fmt.Errorf("`layout` is required to sort by timestamp")
+			}
+			layout, err := strptime.ToNative(rule.Layout)
+			if err != nil {
+				return nil, fmt.Errorf("parse strptime layout: %w", err)
+			}
+			r.layout = layout
+			r.location = time.Local
+			if rule.Location != "" {
+				if r.location, err = time.LoadLocation(rule.Location); err != nil {
+					return nil, fmt.Errorf("failed to load location %s: %w", rule.Location, err)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("invalid sort_type '%s'", rule.SortType)
+		}
+
+		if o.regex == nil {
+			return nil, fmt.Errorf("`ordering_criteria.regex` is required to sort by %s", rule.SortType)
+		}
+		r.group = o.regex.SubexpIndex(rule.RegexKey)
+		if r.group < 0 {
+			return nil, fmt.Errorf("`ordering_criteria.regex` has no capture group named '%s'", rule.RegexKey)
+		}
+		o.rules = append(o.rules, r)
+	}
+
+	return o, nil
+}
+
+// sortValues holds the values extracted from a file for each sort rule
+type sortValues struct {
+	path    string
+	numbers []int64
+	strings []string
+	times   []time.Time
+}
+
+// apply sorts the paths and keeps the first topN. Files whose name
+// does not match the regex, or whose values cannot be parsed, are excluded.
+func (o *orderer) apply(paths []string) []string {
+	files := make([]sortValues, 0, len(paths))
+	for _, path := range paths {
+		if values, ok := o.values(path); ok {
+			files = append(files, values)
+		}
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		for k, rule := range o.rules {
+			var cmp int
+			switch rule.SortType {
+			case sortTypeNumeric:
+				cmp = compareInt64(files[i].numbers[k], files[j].numbers[k])
+			case sortTypeAlphabetical:
+				cmp = strings.Compare(files[i].strings[k], files[j].strings[k])
+			case sortTypeTimestamp, sortTypeMtime:
+				cmp = compareTime(files[i].times[k], files[j].times[k])
+			}
+			if cmp != 0 {
+				return (cmp < 0) == rule.Ascending
+			}
+		}
+		return false
+	})
+
+	if len(files) > o.topN {
+		files = files[:o.topN]
+	}
+
+	result := make([]string, 0, len(files))
+	for _, file := range files {
+		result = append(result, file.path)
+	}
+	return result
+}
+
+func (o *orderer) values(path string) (sortValues, bool) {
+	values := sortValues{
+		path:    path,
+		numbers: make([]int64, len(o.rules)),
+		strings: make([]string, len(o.rules)),
+		times:   make([]time.Time, len(o.rules)),
+	}
+
+	var groups []string
+	if o.regex != nil {
+		if groups = o.regex.FindStringSubmatch(filepath.Base(path)); groups == nil {
+			return values, false
+		}
+	}
+
+	for k, rule := range o.rules {
+		switch rule.SortType {
+		case sortTypeNumeric:
+			n, err := strconv.ParseInt(groups[rule.group], 10, 64)
+			if err != nil {
+				return values, false
+			}
+			values.numbers[k] = n
+		case sortTypeAlphabetical:
+			values.strings[k] = groups[rule.group]
+		case sortTypeTimestamp:
+			t, err := time.ParseInLocation(rule.layout, groups[rule.group], rule.location)
+			if err != nil {
+				return values, false
+			}
+			values.times[k] = t
+		case sortTypeMtime:
+			info, err := os.Stat(path)
+			if err != nil {
+				return values, false
+			}
+			values.times[k] = info.ModTime()
+		}
+	}
+	return values, true
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestOrderingCriteria(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		criteria OrderingCriteria
+		files    []string
+		expected []string
+	}{
+		{
+			name: "Timestamp",
+			criteria: OrderingCriteria{
+				Regex:  `app-(?P<date>\d{8})\.log`,
+				TopN:   2,
+				SortBy: []SortRule{{RegexKey: "date", SortType: "timestamp", Layout: "%Y%m%d", Location: "UTC"}},
+			},
+			files:    []string{"app-20261014.log", "app-20261016.log", "app-20261015.log"},
+			expected: []string{"app-20261016.log", "app-20261015.log"},
+		},
+		{
+			name: "TimestampDefaultTopN",
+			criteria: OrderingCriteria{
+				Regex:  `app-(?P<date>\d{8})\.log`,
+				SortBy: []SortRule{{RegexKey: "date", SortType: "timestamp", Layout: "%Y%m%d"}},
+			},
+			files:    []string{"app-20261014.log", "app-20261016.log", "app-20261015.log"},
+			expected: []string{"app-20261016.log"},
+		},
+		{
+			name: "TimestampAscending",
+			criteria: OrderingCriteria{
+				Regex:  `app-(?P<date>\d{8})\.log`,
+				TopN:   2,
+				SortBy: []SortRule{{RegexKey: "date", SortType: "timestamp", Layout: "%Y%m%d", Ascending: true}},
+			},
+			files:    []string{"app-20261014.log", "app-20261016.log", "app-20261015.log"},
+			expected: []string{"app-20261014.log", "app-20261015.log"},
+		},
+		{
+			name: "Numeric",
+			criteria: OrderingCriteria{
+				Regex:  `app\.(?P<num>\d+)\.log`,
+				TopN:   2,
+				SortBy: []SortRule{{RegexKey: "num", SortType: "numeric"}},
+			},
+			files:    []string{"app.2.log", "app.10.log", "app.9.log"},
+			expected: []string{"app.10.log", "app.9.log"},
+		},
+		{
+			name: "Alphabetical",
+			criteria: OrderingCriteria{
+				Regex:  `app\.(?P<num>\d+)\.log`,
+				TopN:   2,
+				SortBy: []SortRule{{RegexKey: "num", SortType: "alphabetical"}},
+			},
+			files:    []string{"app.2.log", "app.10.log", "app.9.log"},
+			expected: []string{"app.9.log", "app.2.log"},
+		},
+		{
+			name: "ExcludeNotMatching",
+			criteria: OrderingCriteria{
+				Regex:  `app-(?P<date>\d{8})\.log`,
+				TopN:   5,
+				SortBy: []SortRule{{RegexKey: "date", SortType: "timestamp", Layout: "%Y%m%d"}},
+			},
+			files:    []string{"app-20261014.log", "app-latest.log", "app-20261399.log", "other.log"},
+			expected: []string{"app-20261014.log"},
+		},
+		{
+			name: "MultipleRules",
+			criteria: OrderingCriteria{
+				Regex: `(?P<name>[a-z]+)-(?P<num>\d+)\.log`,
+				TopN:  4,
+				SortBy: []SortRule{
+					{RegexKey: "name", SortType: "alphabetical", Ascending: true},
+					{RegexKey: "num", SortType: "numeric"},
+				},
+			},
+			files:    []string{"b-1.log", "a-1.log", "b-2.log", "a-2.log"},
+			expected: []string{"a-2.log", "a-1.log", "b-2.log", "b-1.log"},
+		},
+		{
+			name: "Mtime",
+			criteria: OrderingCriteria{
+				TopN:   2,
+				SortBy: []SortRule{{SortType: "mtime"}},
+			},
+			files:    []string{"a.log", "b.log", "c.log"},
+			expected: []string{"c.log", "b.log"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tempDir := t.TempDir()
+			files := absPath(tempDir, tc.files)
+			expected := absPath(tempDir, tc.expected)
+
+			// Files are written with increasing modification times
+			modTime := time.Now().Add(-time.Hour)
+			for _, f := range files {
+				require.NoError(t, os.WriteFile(f, []byte(filepath.Base(f)), 0600))
+				require.NoError(t, os.Chtimes(f, modTime, modTime))
+				modTime = modTime.Add(time.Minute)
+			}
+
+			orderer, err := tc.criteria.build()
+			require.NoError(t, err)
+			require.Equal(t, expected, orderer.apply(files))
+		})
+	}
+}
+
+func TestOrderingCriteriaBuild(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		criteria OrderingCriteria
+	}{
+		{
+			name:     "MissingSortBy",
+			criteria: OrderingCriteria{Regex: `(?P<num>\d+)`},
+		},
+		{
+			name: "NegativeTopN",
+			criteria: OrderingCriteria{
+				Regex:  `(?P<num>\d+)`,
+				TopN:   -1,
+				SortBy: []SortRule{{RegexKey: "num", SortType: "numeric"}},
+			},
+		},
+		{
+			name: "InvalidRegex",
+			criteria: OrderingCriteria{
+				Regex:  `(`,
+				SortBy: []SortRule{{RegexKey: "num", SortType: "numeric"}},
+			},
+		},
+		{
+			name: "MissingRegex",
+			criteria: OrderingCriteria{
+				SortBy: []SortRule{{RegexKey: "num", SortType: "numeric"}},
+			},
+		},
+		{
+			name: "UnknownRegexKey",
+			criteria: OrderingCriteria{
+				Regex:  `(?P<num>\d+)`,
+				SortBy: []SortRule{{RegexKey: "other", SortType: "numeric"}},
+			},
+		},
+		{
+			name: "InvalidSortType",
+			criteria: OrderingCriteria{
+				Regex:  `(?P<num>\d+)`,
+				SortBy: []SortRule{{RegexKey: "num", SortType: "size"}},
+			},
+		},
+		{
+			name: "MissingLayout",
+			criteria: OrderingCriteria{
+				Regex:  `(?P<date>\d+)`,
+				SortBy: []SortRule{{RegexKey: "date", SortType: "timestamp"}},
+			},
+		},
+		{
+			name: "InvalidLocation",
+			criteria: OrderingCriteria{
+				Regex:  `(?P<date>\d+)`,
+				SortBy: []SortRule{{RegexKey: "date", SortType: "timestamp", Layout: "%Y%m%d", Location: "Mars/Olympus"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tc.criteria.build()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestOrderingCriteriaReadsTopN(t *testing.T) {
+	t.Parallel()
+	operator, emitCalls, tempDir := newTestScenario(t, func(cfg *Config) {
+		cfg.OrderingCriteria = OrderingCriteria{
+			Regex:  `app-(?P<date>\d{8})\.log`,
+			SortBy: []SortRule{{RegexKey: "date", SortType: "timestamp", Layout: "%Y%m%d"}},
+		}
+	})
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app-20261015.log"), []byte("old\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app-20261016.log"), []byte("new\n"), 0600))
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("new"))
+	expectNoTokens(t, emitCalls)
+}
@@ -15,8 +15,10 @@
 package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"go.uber.org/zap"
@@ -29,9 +31,16 @@ type Reader struct {
 	Fingerprint *Fingerprint
 	Offset      int64
 
+	// Completed is set once a compressed file has been read in full.
+	// Compressed files are not expected to change, so they are not read again.
+	Completed bool
+
 	generation     int
 	fileInput      *Input
 	file           *os.File
+	src            io.Reader
+	compressed     bool
+	deleted        bool
 	fileAttributes *FileAttributes
 
 	splitter *helper.Splitter
@@ -50,6 +59,8 @@ func (f *Input) NewReader(file *os.File, fp *Fingerprint, splitter *helper.Split
 		SugaredLogger:  f.SugaredLogger.With("path", path),
 		Fingerprint:    fp,
 		file:           file,
+		src:            file,
+		compressed:     f.isCompressed(file),
 		fileInput:      f,
 		fileAttributes: attrs,
 		splitter:       splitter,
@@ -64,12 +75,18 @@ func (r *Reader) Copy(file *os.File) (*Reader, error) {
 		return nil, err
 	}
 	reader.Offset = r.Offset
+	reader.Completed = r.Completed && reader.compressed
 	return reader, nil
 }
 
 // InitializeOffset sets the starting offset
 func (r *Reader) InitializeOffset(startAtBeginning bool) error {
 	if !startAtBeginning {
+		if r.compressed {
+			// The decompressed size is unknown, so skip the file entirely
+			r.Completed = true
+			return nil
+		}
 		info, err := r.file.Stat()
 		if err != nil {
 			return fmt.Errorf("stat: %w", err)
@@ -81,18 +98,79 @@ func (r *Reader) InitializeOffset(startAtBeginning bool) error {
 
 // ReadToEnd will read until the end of the file
 func (r *Reader) ReadToEnd(ctx context.Context) {
-	if _, err := r.file.Seek(r.Offset, 0); err != nil {
+	var eof bool
+	if r.compressed {
+		eof = r.readCompressedToEnd(ctx)
+	} else if _, err := r.file.Seek(r.Offset, 0); err != nil {
 		r.Errorw("Failed to seek", zap.Error(err))
+	} else {
+		eof = r.scan(ctx, r.splitter.SplitFunc)
+	}
+
+	if eof && r.fileInput.deleteAfterRead {
+		r.delete()
+	}
+}
+
+// delete closes and removes the file once it has been read in full
+func (r *Reader) delete() {
+	r.Close()
+	if err := os.Remove(r.file.Name()); err != nil {
+		r.Errorw("Failed to delete file", zap.Error(err))
 		return
 	}
+	r.deleted = true
+}
+
+// readCompressedToEnd decompresses the file from its beginning, skips
+// everything up to the offset and reads the rest. It returns true once
+// the whole stream has been read, marking the file as completed.
+func (r *Reader) readCompressedToEnd(ctx context.Context) bool {
+	if r.Completed {
+		return true
+	}
+
+	src, err := newGzipSource(r.file)
+	if err != nil {
+		r.Errorw("Failed to read gzip header", zap.Error(err))
+		return false
+	}
+	defer src.Close()
+
+	if _, err = io.CopyN(io.Discard, src, r.Offset); err != nil {
+		r.Errorw("Failed to skip to offset", zap.Error(err))
+		return false
+	}
+
+	// The last token does not need to be terminated, but only once the stream is known
+	// to be complete. A truncated file may still be in the process of being written.
+	splitter, err := r.fileInput.SplitterConfig.Build(true, r.fileInput.MaxLogSize)
+	if err != nil {
+		r.Errorw("Failed to build splitter", zap.Error(err))
+		return false
+	}
+	splitFunc := func(data []byte, atEOF bool) (int, []byte, error) {
+		return splitter.SplitFunc(data, atEOF && src.eof)
+	}
+
+	r.src = src
+	defer func() { r.src = r.file }()
+	if r.scan(ctx, splitFunc) && src.eof {
+		r.Completed = true
+	}
+	return r.Completed
+}
 
-	scanner := NewPositionalScanner(r, r.fileInput.MaxLogSize, r.Offset, r.splitter.SplitFunc)
+// scan emits every token read from the source and returns
+// true if the end of the source was reached without errors
+func (r *Reader) scan(ctx context.Context, splitFunc bufio.SplitFunc) bool {
+	scanner := NewPositionalScanner(r, r.fileInput.MaxLogSize, r.Offset, splitFunc)
 
 	// Iterate over the tokenized file, emitting entries as we go
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return false
 		default:
 		}
 
@@ -100,8 +178,9 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		if !ok {
 			if err := scanner.getError(); err != nil {
 				r.Errorw("Failed during scan", zap.Error(err))
+				return false
 			}
-			break
+			return true
 		}
 
 		token, err := r.splitter.Encoding.Decode(scanner.Bytes())
@@ -129,9 +208,9 @@ func (r *Reader) Read(dst []byte) (int, error) {
 	// Skip if fingerprint is already built
 	// or if fingerprint is behind Offset
 	if len(r.Fingerprint.FirstBytes) == r.fileInput.fingerprintSize || int(r.Offset) > len(r.Fingerprint.FirstBytes) {
-		return r.file.Read(dst)
+		return r.src.Read(dst)
 	}
-	n, err := r.file.Read(dst)
+	n, err := r.src.Read(dst)
 	appendCount := min0(n, r.fileInput.fingerprintSize-int(r.Offset))
 	// return for n == 0 or r.Offset >= r.fileInput.fingerprintSize
 	if appendCount == 0 {
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper/operatortest"
+)
+
+func TestConfig(t *testing.T) {
+	cases := []operatortest.ConfigUnmarshalTest{
+		{
+			Name:   "default",
+			Expect: defaultCfg(),
+		},
+		{
+			Name: "format",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.Format = "docker"
+				return cfg
+			}(),
+		},
+		{
+			Name: "add_metadata_from_file_path",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.AddMetadataFromFilePath = false
+				return cfg
+			}(),
+		},
+		{
+			Name: "parse_from_simple",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.ParseFrom = entry.NewBodyField("from")
+				return cfg
+			}(),
+		},
+		{
+			Name: "on_error_drop",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.OnError = "drop"
+				return cfg
+			}(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Run(t, defaultCfg())
+		})
+	}
+}
+
+func defaultCfg() *Config {
+	return NewConfig("container")
+}
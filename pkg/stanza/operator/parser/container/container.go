@@ -0,0 +1,308 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/container"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/errors"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/recombine"
+)
+
+const operatorType = "container"
+
+const (
+	dockerFormat     = "docker"
+	crioFormat       = "crio"
+	containerdFormat = "containerd"
+)
+
+const (
+	streamAttribute = "log.iostream"
+	logTagAttribute = "logtag"
+	filePathField   = "log.file.path"
+
+	// Log tags of the CRI logging format, marking partial and full lines
+	partialLogTag = "P"
+	fullLogTag    = "F"
+)
+
+// podLogPath matches the path of the log files written by the kubelet, such as
+// /var/log/pods/<namespace>_<pod_name>_<pod_uid>/<container_name>/<restart_count>.log
+var podLogPath = regexp.MustCompile(`^.*/(?P<namespace>[^_/]+)_(?P<pod_name>[^_/]+)_(?P<uid>[a-f0-9\-]+)/(?P<container_name>[^\._/]+)/(?P<restart_count>\d+)\.log$`)
+
+// podLogPathResource maps the groups of podLogPath to resource attributes
+var podLogPathResource = map[string]string{
+	"namespace":      "k8s.namespace.name",
+	"pod_name":       "k8s.pod.name",
+	"uid":            "k8s.pod.uid",
+	"container_name": "k8s.container.name",
+	"restart_count":  "k8s.container.restart_count",
+}
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig("") })
+}
+
+// NewConfig creates a new container parser config with default values
+func NewConfig(operatorID string) *Config {
+	return &Config{
+		TransformerConfig:       helper.NewTransformerConfig(operatorID, operatorType),
+		ParseFrom:               entry.NewBodyField(),
+		AddMetadataFromFilePath: true,
+	}
+}
+
+// Config is the configuration of a container parser operator.
+type Config struct {
+	helper.TransformerConfig `mapstructure:",squash" yaml:",inline"`
+
+	ParseFrom               entry.Field `mapstructure:"parse_from"                  json:"parse_from"                  yaml:"parse_from"`
+	Format                  string      `mapstructure:"format"                      json:"format"                      yaml:"format"`
+	AddMetadataFromFilePath bool        `mapstructure:"add_metadata_from_file_path" json:"add_metadata_from_file_path" yaml:"add_metadata_from_file_path"`
+}
+
+// Build will build a container parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	transformerOperator, err := c.TransformerConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Format {
+	case "", dockerFormat, crioFormat, containerdFormat:
+	default:
+		return nil, fmt.Errorf("invalid value '%s' for parameter 'format'", c.Format)
+	}
+
+	// Partial lines of the CRI logging format are reassembled by a recombine
+	// operator writing to the outputs of the parser
+	recombineConfig := recombine.NewConfig(c.ID() + "_recombine")
+	recombineConfig.IsLastEntry = fmt.Sprintf("attributes.%s == '%s'", logTagAttribute, fullLogTag)
+	recombineConfig.CombineField = entry.NewBodyField()
+	recombineConfig.CombineWith = ""
+	recombineConfig.SourceIdentifier = entry.NewAttributeField(filePathField)
+	recombineConfig.OverwriteWith = "newest"
+	recombineConfig.OutputIDs = c.OutputIDs
+	recombineOperator, err := recombineConfig.Build(logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the recombine operator for partial lines: %w", err)
+	}
+
+	return &Parser{
+		TransformerOperator:     transformerOperator,
+		parseFrom:               c.ParseFrom,
+		format:                  c.Format,
+		addMetadataFromFilePath: c.AddMetadataFromFilePath,
+		json:                    jsoniter.ConfigFastest,
+		recombine:               recombineOperator,
+	}, nil
+}
+
+// Parser is an operator that parses the log lines written by container runtimes.
+type Parser struct {
+	helper.TransformerOperator
+	parseFrom               entry.Field
+	format                  string
+	addMetadataFromFilePath bool
+	json                    jsoniter.API
+	recombine               operator.Operator
+	metadataWarning         sync.Once
+}
+
+// containerLog is a log line written by a container runtime
+type containerLog struct {
+	Log    string `json:"log"`
+	Stream string `json:"stream"`
+	Time   string `json:"time"`
+	LogTag string `json:"-"`
+}
+
+// Start will start the recombine operator of the parser.
+func (p *Parser) Start(persister operator.Persister) error {
+	return p.recombine.Start(persister)
+}
+
+// Stop will stop the recombine operator of the parser, flushing any partial line.
+func (p *Parser) Stop() error {
+	return p.recombine.Stop()
+}
+
+// SetOutputs will set the outputs of the parser and of its recombine operator.
+func (p *Parser) SetOutputs(operators []operator.Operator) error {
+	if err := p.TransformerOperator.SetOutputs(operators); err != nil {
+		return err
+	}
+	return p.recombine.SetOutputs(operators)
+}
+
+// SetOutputIDs will set the outputs of the parser and of its recombine operator.
+func (p *Parser) SetOutputIDs(opIDs []string) {
+	p.TransformerOperator.SetOutputIDs(opIDs)
+	p.recombine.SetOutputIDs(opIDs)
+}
+
+// Process will parse an entry as a container log line.
+func (p *Parser) Process(ctx context.Context, e *entry.Entry) error {
+	// Short circuit if the "if" condition does not match
+	skip, err := p.Skip(ctx, e)
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+	if skip {
+		p.Write(ctx, e)
+		return nil
+	}
+
+	value, ok := e.Get(p.parseFrom)
+	if !ok {
+		err := errors.NewError(
+			"Entry is missing the expected parse_from field.",
+			"Ensure that all incoming entries contain the parse_from field.",
+			"parse_from", p.parseFrom.String(),
+		)
+		return p.HandleEntryError(ctx, e, err)
+	}
+
+	line, ok := value.(string)
+	if !ok {
+		return p.HandleEntryError(ctx, e, fmt.Errorf("type '%T' cannot be parsed as a container log", value))
+	}
+
+	format := p.format
+	if format == "" {
+		format = detectFormat(line)
+	}
+
+	var parsed containerLog
+	if format == dockerFormat {
+		parsed, err = p.parseDocker(line)
+	} else {
+		parsed, err = parseCRI(line)
+	}
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+
+	if err = setFields(e, parsed); err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+
+	if p.addMetadataFromFilePath {
+		if err = addMetadataFromFilePath(e); err != nil {
+			// The metadata is optional, so that files which do not follow the
+			// layout of /var/log/pods are still parsed and reassembled
+			p.metadataWarning.Do(func() {
+				p.Warnw("Failed to add metadata from the file path, further failures will not be logged", zap.Error(err))
+			})
+		}
+	}
+
+	if format == dockerFormat {
+		p.Write(ctx, e)
+		return nil
+	}
+	return p.recombine.Process(ctx, e)
+}
+
+// detectFormat detects the format of a container log line. Docker writes
+// JSON objects, while CRI-O and containerd share the CRI logging format.
+func detectFormat(line string) string {
+	if strings.HasPrefix(line, "{") {
+		return dockerFormat
+	}
+	return crioFormat
+}
+
+// parseDocker parses a line of the docker json-file logging driver, such as
+// {"log":"message\n","stream":"stdout","time":"2022-07-11T12:00:00.000000000Z"}
+func (p *Parser) parseDocker(line string) (containerLog, error) {
+	var parsed containerLog
+	if err := p.json.UnmarshalFromString(line, &parsed); err != nil {
+		return containerLog{}, fmt.Errorf("failed to parse docker log: %w", err)
+	}
+	parsed.Log = strings.TrimSuffix(parsed.Log, "\n")
+	return parsed, nil
+}
+
+// parseCRI parses a line of the CRI logging format used by CRI-O and
+// containerd, such as 2022-07-11T12:00:00.000000000Z stdout F message
+func parseCRI(line string) (containerLog, error) {
+	fields := strings.SplitN(line, " ", 4)
+	if len(fields) < 3 {
+		return containerLog{}, fmt.Errorf("failed to parse CRI log: expected '<time> <stream> <logtag> <log>' but got '%s'", line)
+	}
+
+	parsed := containerLog{
+		Time:   fields[0],
+		Stream: fields[1],
+		LogTag: fields[2],
+	}
+	if parsed.LogTag != partialLogTag && parsed.LogTag != fullLogTag {
+		return containerLog{}, fmt.Errorf("failed to parse CRI log: invalid log tag '%s'", parsed.LogTag)
+	}
+	if len(fields) == 4 {
+		parsed.Log = fields[3]
+	}
+	return parsed, nil
+}
+
+// setFields sets the log as the body of the entry, and its time, stream and
+// log tag as the timestamp and attributes of the entry
+func setFields(e *entry.Entry, parsed containerLog) error {
+	timestamp, err := time.Parse(time.RFC3339Nano, parsed.Time)
+	if err != nil {
+		return fmt.Errorf("failed to parse time: %w", err)
+	}
+	e.Timestamp = timestamp
+	e.Body = parsed.Log
+	e.AddAttribute(streamAttribute, parsed.Stream)
+	if parsed.LogTag != "" {
+		e.AddAttribute(logTagAttribute, parsed.LogTag)
+	}
+	return nil
+}
+
+// addMetadataFromFilePath adds the namespace, pod and container of the log
+// to the resource of the entry, based on the path of its log file
+func addMetadataFromFilePath(e *entry.Entry) error {
+	var path string
+	if err := e.Read(entry.NewAttributeField(filePathField), &path); err != nil {
+		return fmt.Errorf("failed to read the '%s' attribute: %w", filePathField, err)
+	}
+
+	matches := podLogPath.FindStringSubmatch(path)
+	if matches == nil {
+		return fmt.Errorf("failed to detect the pod metadata from the file path '%s'", path)
+	}
+
+	for i, name := range podLogPath.SubexpNames() {
+		if key, ok := podLogPathResource[name]; ok {
+			e.AddResourceKey(key, matches[i])
+		}
+	}
+	return nil
+}
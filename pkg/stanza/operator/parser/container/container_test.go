@@ -0,0 +1,299 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+const podLogFile = "/var/log/pods/default_checkout-5f7d8b_49cc7c1fd3702c40b2686ea7486091d6/checkout/1.log"
+
+func newTestParser(t *testing.T, cfg *Config) (*Parser, *testutil.FakeOutput) {
+	cfg.OutputIDs = []string{"fake"}
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+	return op.(*Parser), fake
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("container")
+	require.True(t, ok, "expected container to be registered")
+	require.Equal(t, "container", builder().Type())
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	cfg := NewConfig("test")
+	cfg.Format = "invalid"
+	_, err := cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid value 'invalid' for parameter 'format'")
+}
+
+func TestProcess(t *testing.T) {
+	ts := time.Date(2022, time.July, 11, 12, 0, 0, 123456789, time.UTC)
+
+	cases := []struct {
+		name   string
+		config func() *Config
+		input  string
+		expect *entry.Entry
+	}{
+		{
+			"docker",
+			func() *Config { return NewConfig("test") },
+			`{"log":"message\n","stream":"stdout","time":"2022-07-11T12:00:00.123456789Z"}`,
+			&entry.Entry{
+				Timestamp: ts,
+				Body:      "message",
+				Attributes: map[string]interface{}{
+					"log.file.path": podLogFile,
+					"log.iostream":  "stdout",
+				},
+				Resource: map[string]interface{}{
+					"k8s.namespace.name":          "default",
+					"k8s.pod.name":                "checkout-5f7d8b",
+					"k8s.pod.uid":                 "49cc7c1fd3702c40b2686ea7486091d6",
+					"k8s.container.name":          "checkout",
+					"k8s.container.restart_count": "1",
+				},
+			},
+		},
+		{
+			"crio",
+			func() *Config { return NewConfig("test") },
+			"2022-07-11T14:00:00.123456789+02:00 stderr F message with spaces",
+			&entry.Entry{
+				Timestamp: ts.In(time.FixedZone("", 2*60*60)),
+				Body:      "message with spaces",
+				Attributes: map[string]interface{}{
+					"log.file.path": podLogFile,
+					"log.iostream":  "stderr",
+					"logtag":        "F",
+				},
+				Resource: map[string]interface{}{
+					"k8s.namespace.name":          "default",
+					"k8s.pod.name":                "checkout-5f7d8b",
+					"k8s.pod.uid":                 "49cc7c1fd3702c40b2686ea7486091d6",
+					"k8s.container.name":          "checkout",
+					"k8s.container.restart_count": "1",
+				},
+			},
+		},
+		{
+			"containerd_without_metadata",
+			func() *Config {
+				cfg := NewConfig("test")
+				cfg.Format = "containerd"
+				cfg.AddMetadataFromFilePath = false
+				return cfg
+			},
+			"2022-07-11T12:00:00.123456789Z stdout F message",
+			&entry.Entry{
+				Timestamp: ts,
+				Body:      "message",
+				Attributes: map[string]interface{}{
+					"log.file.path": podLogFile,
+					"log.iostream":  "stdout",
+					"logtag":        "F",
+				},
+			},
+		},
+		{
+			"empty_log",
+			func() *Config {
+				cfg := NewConfig("test")
+				cfg.AddMetadataFromFilePath = false
+				return cfg
+			},
+			"2022-07-11T12:00:00.123456789Z stdout F",
+			&entry.Entry{
+				Timestamp: ts,
+				Body:      "",
+				Attributes: map[string]interface{}{
+					"log.file.path": podLogFile,
+					"log.iostream":  "stdout",
+					"logtag":        "F",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser, fake := newTestParser(t, tc.config())
+
+			input := entry.New()
+			input.Body = tc.input
+			input.AddAttribute("log.file.path", podLogFile)
+			require.NoError(t, parser.Process(context.Background(), input))
+
+			tc.expect.ObservedTimestamp = input.ObservedTimestamp
+			fake.ExpectEntry(t, tc.expect)
+			require.NoError(t, parser.Stop())
+		})
+	}
+}
+
+func TestProcessPartialLines(t *testing.T) {
+	cfg := NewConfig("test")
+	cfg.AddMetadataFromFilePath = false
+	parser, fake := newTestParser(t, cfg)
+
+	for _, line := range []string{
+		"2022-07-11T12:00:00.1Z stdout P first ",
+		"2022-07-11T12:00:00.2Z stdout P second ",
+		"2022-07-11T12:00:00.3Z stdout F third",
+	} {
+		input := entry.New()
+		input.Body = line
+		input.AddAttribute("log.file.path", podLogFile)
+		require.NoError(t, parser.Process(context.Background(), input))
+	}
+
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, "first second third", e.Body)
+		require.Equal(t, "F", e.Attributes["logtag"])
+		require.Equal(t, time.Date(2022, time.July, 11, 12, 0, 0, 300000000, time.UTC), e.Timestamp)
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry")
+	}
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+	require.NoError(t, parser.Stop())
+}
+
+func TestProcessPartialLinesFlushedOnStop(t *testing.T) {
+	cfg := NewConfig("test")
+	cfg.AddMetadataFromFilePath = false
+	parser, fake := newTestParser(t, cfg)
+
+	input := entry.New()
+	input.Body = "2022-07-11T12:00:00.1Z stdout P partial"
+	input.AddAttribute("log.file.path", podLogFile)
+	require.NoError(t, parser.Process(context.Background(), input))
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+
+	require.NoError(t, parser.Stop())
+	fake.ExpectBody(t, "partial")
+}
+
+func TestProcessWithoutPodFilePath(t *testing.T) {
+	cases := []struct {
+		name  string
+		attrs map[string]string
+	}{
+		{
+			"containers_symlink",
+			map[string]string{"log.file.path": "/var/log/containers/checkout.log"},
+		},
+		{
+			"missing_file_path",
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser, fake := newTestParser(t, NewConfig("test"))
+
+			for _, line := range []string{
+				"2022-07-11T12:00:00.1Z stdout P first ",
+				"2022-07-11T12:00:00.2Z stdout F second",
+			} {
+				input := entry.New()
+				input.Body = line
+				for k, v := range tc.attrs {
+					input.AddAttribute(k, v)
+				}
+				require.NoError(t, parser.Process(context.Background(), input))
+			}
+
+			select {
+			case e := <-fake.Received:
+				require.Equal(t, "first second", e.Body)
+				require.Empty(t, e.Resource)
+			case <-time.After(time.Second):
+				require.FailNow(t, "Timed out waiting for entry")
+			}
+			fake.ExpectNoEntry(t, 100*time.Millisecond)
+			require.NoError(t, parser.Stop())
+		})
+	}
+}
+
+func TestProcessErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    interface{}
+		filePath string
+		err      string
+	}{
+		{
+			"invalid_type",
+			[]byte("message"),
+			podLogFile,
+			"type '[]uint8' cannot be parsed as a container log",
+		},
+		{
+			"invalid_docker",
+			`{"log":`,
+			podLogFile,
+			"failed to parse docker log",
+		},
+		{
+			"invalid_cri",
+			"2022-07-11T12:00:00.1Z",
+			podLogFile,
+			"failed to parse CRI log",
+		},
+		{
+			"invalid_log_tag",
+			"2022-07-11T12:00:00.1Z stdout X message",
+			podLogFile,
+			"invalid log tag 'X'",
+		},
+		{
+			"invalid_time",
+			"yesterday stdout F message",
+			podLogFile,
+			"failed to parse time",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser, _ := newTestParser(t, NewConfig("test"))
+
+			input := entry.New()
+			input.Body = tc.input
+			input.AddAttribute("log.file.path", tc.filePath)
+			err := parser.Process(context.Background(), input)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+			require.NoError(t, parser.Stop())
+		})
+	}
+}
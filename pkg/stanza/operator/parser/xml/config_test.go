@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xml
+
+import (
+	"testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper/operatortest"
+)
+
+func TestConfig(t *testing.T) {
+	cases := []operatortest.ConfigUnmarshalTest{
+		{
+			Name:   "default",
+			Expect: defaultCfg(),
+		},
+		{
+			Name: "parse_from_simple",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.ParseFrom = entry.NewBodyField("from")
+				return cfg
+			}(),
+		},
+		{
+			Name: "parse_to_simple",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.ParseTo = entry.NewBodyField("log")
+				return cfg
+			}(),
+		},
+		{
+			Name: "on_error_drop",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.OnError = "drop"
+				return cfg
+			}(),
+		},
+		{
+			Name: "attribute_prefix",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.AttributePrefix = "_"
+				return cfg
+			}(),
+		},
+		{
+			Name: "text_key",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.TextKey = "value"
+				return cfg
+			}(),
+		},
+		{
+			Name: "repeated_elements",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.RepeatedElements = "first"
+				return cfg
+			}(),
+		},
+		{
+			Name: "namespaces",
+			Expect: func() *Config {
+				cfg := defaultCfg()
+				cfg.Namespaces = "prefix"
+				return cfg
+			}(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Run(t, defaultCfg())
+		})
+	}
+}
+
+func defaultCfg() *Config {
+	return NewConfig("xml_parser")
+}
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xml // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/xml"
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "xml_parser"
+
+// Handling of elements repeated under the same parent
+const (
+	repeatedAsArray = "array"
+	repeatedFirst   = "first"
+	repeatedLast    = "last"
+)
+
+// Handling of the namespaces of elements and attributes
+const (
+	namespacesStrip  = "strip"
+	namespacesPrefix = "prefix"
+)
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig("") })
+}
+
+// NewConfig creates a new XML parser config with default values
+func NewConfig(operatorID string) *Config {
+	return &Config{
+		ParserConfig:     helper.NewParserConfig(operatorID, operatorType),
+		AttributePrefix:  "@",
+		TextKey:          "#text",
+		RepeatedElements: repeatedAsArray,
+		Namespaces:       namespacesStrip,
+	}
+}
+
+// Config is the configuration of an XML parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash" yaml:",inline"`
+
+	AttributePrefix  string `mapstructure:"attribute_prefix"  json:"attribute_prefix"  yaml:"attribute_prefix"`
+	TextKey          string `mapstructure:"text_key"          json:"text_key"          yaml:"text_key"`
+	RepeatedElements string `mapstructure:"repeated_elements" json:"repeated_elements" yaml:"repeated_elements"`
+	Namespaces       string `mapstructure:"namespaces"        json:"namespaces"        yaml:"namespaces"`
+}
+
+// Build will build an XML parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.TextKey == "" {
+		return nil, errors.New("text_key is a required parameter")
+	}
+
+	switch c.RepeatedElements {
+	case repeatedAsArray, repeatedFirst, repeatedLast:
+	default:
+		return nil, fmt.Errorf("invalid value '%s' for parameter 'repeated_elements'", c.RepeatedElements)
+	}
+
+	switch c.Namespaces {
+	case namespacesStrip, namespacesPrefix:
+	default:
+		return nil, fmt.Errorf("invalid value '%s' for parameter 'namespaces'", c.Namespaces)
+	}
+
+	return &Parser{
+		ParserOperator:   parserOperator,
+		attributePrefix:  c.AttributePrefix,
+		textKey:          c.TextKey,
+		repeatedElements: c.RepeatedElements,
+		keepPrefixes:     c.Namespaces == namespacesPrefix,
+	}, nil
+}
+
+// Parser is an operator that parses XML.
+type Parser struct {
+	helper.ParserOperator
+	attributePrefix  string
+	textKey          string
+	repeatedElements string
+	keepPrefixes     bool
+}
+
+// element is an XML element being parsed
+type element struct {
+	name   string
+	fields map[string]interface{}
+	text   strings.Builder
+}
+
+// Process will parse an entry for XML.
+func (x *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return x.ParserOperator.ProcessWith(ctx, entry, x.parse)
+}
+
+// parse will parse a value as XML.
+func (x *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return x.parseDocument(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as XML", value)
+	}
+}
+
+// parseDocument parses an XML document into a map holding its root element.
+// The attributes of an element are parsed with the attribute prefix, its
+// children by their name, and its text with the text key. Elements without
+// attributes and children are parsed as their text.
+func (x *Parser) parseDocument(document string) (map[string]interface{}, error) {
+	decoder := xml.NewDecoder(strings.NewReader(document))
+
+	var root map[string]interface{}
+	var stack []*element
+	for {
+		// RawToken keeps the namespace prefixes, the names of the end
+		// elements are checked against the stack instead
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if root != nil {
+				return nil, errors.New("failed to parse XML: expected a single root element")
+			}
+			stack = append(stack, x.newElement(t))
+		case xml.EndElement:
+			if len(stack) == 0 || stack[len(stack)-1].name != x.name(t.Name) {
+				return nil, fmt.Errorf("failed to parse XML: unexpected end element '%s'", x.name(t.Name))
+			}
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				root = map[string]interface{}{current.name: x.value(current)}
+				continue
+			}
+			x.addChild(stack[len(stack)-1], current.name, x.value(current))
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			} else if strings.TrimSpace(string(t)) != "" {
+				return nil, errors.New("failed to parse XML: unexpected text outside of the root element")
+			}
+		}
+	}
+
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("failed to parse XML: element '%s' is not closed", stack[len(stack)-1].name)
+	}
+	if root == nil {
+		return nil, errors.New("failed to parse XML: expected a root element")
+	}
+	return root, nil
+}
+
+// newElement creates an element with the attributes of the start element
+func (x *Parser) newElement(start xml.StartElement) *element {
+	e := &element{
+		name:   x.name(start.Name),
+		fields: map[string]interface{}{},
+	}
+	for _, attr := range start.Attr {
+		if !x.keepPrefixes && isNamespaceDeclaration(attr.Name) {
+			continue
+		}
+		e.fields[x.attributePrefix+x.name(attr.Name)] = attr.Value
+	}
+	return e
+}
+
+// value returns the parsed value of an element
+func (x *Parser) value(e *element) interface{} {
+	text := strings.TrimSpace(e.text.String())
+	if len(e.fields) == 0 {
+		return text
+	}
+	if text != "" {
+		e.fields[x.textKey] = text
+	}
+	return e.fields
+}
+
+// addChild adds the value of a child element to its parent, handling the
+// elements repeated under the same parent
+func (x *Parser) addChild(parent *element, name string, value interface{}) {
+	existing, ok := parent.fields[name]
+	if !ok {
+		parent.fields[name] = value
+		return
+	}
+
+	switch x.repeatedElements {
+	case repeatedFirst:
+		return
+	case repeatedLast:
+		parent.fields[name] = value
+	default:
+		// The values of elements are never arrays, so an array holds the
+		// values of the elements already repeated
+		if values, isArray := existing.([]interface{}); isArray {
+			parent.fields[name] = append(values, value)
+			return
+		}
+		parent.fields[name] = []interface{}{existing, value}
+	}
+}
+
+// name returns the name of an element or attribute, with its namespace
+// prefix if configured
+func (x *Parser) name(name xml.Name) string {
+	if x.keepPrefixes && name.Space != "" {
+		return name.Space + ":" + name.Local
+	}
+	return name.Local
+}
+
+// isNamespaceDeclaration returns whether an attribute declares a namespace
+func isNamespaceDeclaration(name xml.Name) bool {
+	return name.Space == "xmlns" || (name.Space == "" && name.Local == "xmlns")
+}
@@ -0,0 +1,268 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xml
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfig("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("xml_parser")
+	require.True(t, ok, "expected xml_parser to be registered")
+	require.Equal(t, "xml_parser", builder().Type())
+}
+
+func TestConfigBuild(t *testing.T) {
+	config := NewConfig("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	require.IsType(t, &Parser{}, op)
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{
+			"invalid_on_error",
+			func(cfg *Config) { cfg.OnError = "invalid_on_error" },
+			"invalid `on_error` field",
+		},
+		{
+			"empty_text_key",
+			func(cfg *Config) { cfg.TextKey = "" },
+			"text_key is a required parameter",
+		},
+		{
+			"invalid_repeated_elements",
+			func(cfg *Config) { cfg.RepeatedElements = "merge" },
+			"invalid value 'merge' for parameter 'repeated_elements'",
+		},
+		{
+			"invalid_namespaces",
+			func(cfg *Config) { cfg.Namespaces = "uri" },
+			"invalid value 'uri' for parameter 'namespaces'",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := NewConfig("test")
+			tc.modify(config)
+			_, err := config.Build(testutil.Logger(t))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as XML")
+}
+
+func TestParserInvalidXML(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"empty", "", "expected a root element"},
+		{"text", "message", "unexpected text outside of the root element"},
+		{"unclosed", "<event><id>1</id>", "element 'event' is not closed"},
+		{"mismatched", "<event><id>1</name></event>", "unexpected end element 'name'"},
+		{"multiple_roots", "<event/><event/>", "expected a single root element"},
+		{"invalid", "<event id=1/>", "failed to parse XML"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser := newTestParser(t)
+			_, err := parser.parse(tc.input)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(*Config)
+		input  string
+		expect map[string]interface{}
+	}{
+		{
+			"text",
+			func(*Config) {},
+			"<message> hello </message>",
+			map[string]interface{}{
+				"message": "hello",
+			},
+		},
+		{
+			"empty_element",
+			func(*Config) {},
+			"<message/>",
+			map[string]interface{}{
+				"message": "",
+			},
+		},
+		{
+			"attributes_children_and_text",
+			func(*Config) {},
+			`<?xml version="1.0" encoding="UTF-8"?>
+<!-- an event -->
+<event id="42" level="error">
+  <source>checkout</source>
+  <details region="us"><code>500</code>timeout</details>
+</event>`,
+			map[string]interface{}{
+				"event": map[string]interface{}{
+					"@id":    "42",
+					"@level": "error",
+					"source": "checkout",
+					"details": map[string]interface{}{
+						"@region": "us",
+						"code":    "500",
+						"#text":   "timeout",
+					},
+				},
+			},
+		},
+		{
+			"attribute_prefix_and_text_key",
+			func(cfg *Config) {
+				cfg.AttributePrefix = ""
+				cfg.TextKey = "value"
+			},
+			`<message level="info">hello</message>`,
+			map[string]interface{}{
+				"message": map[string]interface{}{
+					"level": "info",
+					"value": "hello",
+				},
+			},
+		},
+		{
+			"repeated_elements_as_array",
+			func(*Config) {},
+			"<users><user>a</user><user>b</user><user><name>c</name></user><group>g</group></users>",
+			map[string]interface{}{
+				"users": map[string]interface{}{
+					"user": []interface{}{
+						"a",
+						"b",
+						map[string]interface{}{"name": "c"},
+					},
+					"group": "g",
+				},
+			},
+		},
+		{
+			"repeated_elements_first",
+			func(cfg *Config) { cfg.RepeatedElements = "first" },
+			"<users><user>a</user><user>b</user><user>c</user></users>",
+			map[string]interface{}{
+				"users": map[string]interface{}{
+					"user": "a",
+				},
+			},
+		},
+		{
+			"repeated_elements_last",
+			func(cfg *Config) { cfg.RepeatedElements = "last" },
+			"<users><user>a</user><user>b</user><user>c</user></users>",
+			map[string]interface{}{
+				"users": map[string]interface{}{
+					"user": "c",
+				},
+			},
+		},
+		{
+			"namespaces_strip",
+			func(*Config) {},
+			`<Event xmlns="http://schemas.microsoft.com/win/2004/08/events/event" xmlns:x="urn:x"><System><x:EventID x:Qualifiers="0">4624</x:EventID></System></Event>`,
+			map[string]interface{}{
+				"Event": map[string]interface{}{
+					"System": map[string]interface{}{
+						"EventID": map[string]interface{}{
+							"@Qualifiers": "0",
+							"#text":       "4624",
+						},
+					},
+				},
+			},
+		},
+		{
+			"namespaces_prefix",
+			func(cfg *Config) { cfg.Namespaces = "prefix" },
+			`<Event xmlns="http://schemas.microsoft.com/win/2004/08/events/event" xmlns:x="urn:x"><System><x:EventID x:Qualifiers="0">4624</x:EventID></System></Event>`,
+			map[string]interface{}{
+				"Event": map[string]interface{}{
+					"@xmlns":   "http://schemas.microsoft.com/win/2004/08/events/event",
+					"@xmlns:x": "urn:x",
+					"System": map[string]interface{}{
+						"x:EventID": map[string]interface{}{
+							"@x:Qualifiers": "0",
+							"#text":         "4624",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := NewConfig("test")
+			config.OutputIDs = []string{"fake"}
+			tc.modify(config)
+			op, err := config.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			input := entry.New()
+			input.Body = tc.input
+			require.NoError(t, op.Process(context.Background(), input))
+
+			expected := entry.New()
+			expected.ObservedTimestamp = input.ObservedTimestamp
+			expected.Body = tc.input
+			expected.Attributes = tc.expect
+			fake.ExpectEntry(t, expected)
+		})
+	}
+}
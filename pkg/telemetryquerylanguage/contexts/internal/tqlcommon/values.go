@@ -0,0 +1,253 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlcommon // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/internal/tqlcommon"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// GetMapValue returns the value found in attrs by following keys. The first key must be a string key of attrs, and the
+// following keys index into nested maps and slices. nil is returned if any of the keys can't be found.
+func GetMapValue(attrs pcommon.Map, keys []tql.Key) interface{} {
+	if len(keys) == 0 || keys[0].String == nil {
+		return nil
+	}
+	val, ok := attrs.Get(*keys[0].String)
+	if !ok {
+		return nil
+	}
+	return GetIndexableValue(val, keys[1:])
+}
+
+// GetIndexableValue returns the value found in val by following keys, which index into nested maps and slices.
+// nil is returned if any of the keys can't be found.
+func GetIndexableValue(val pcommon.Value, keys []tql.Key) interface{} {
+	for _, key := range keys {
+		var ok bool
+		val, ok = index(val, key)
+		if !ok {
+			return nil
+		}
+	}
+	return getValue(val)
+}
+
+// SetMapValue sets val into attrs at the location described by keys, following the same rules as GetMapValue.
+// Maps that are missing along the way are created. Nothing is set if a key indexes a value of the wrong type
+// or an index is out of range.
+func SetMapValue(attrs pcommon.Map, keys []tql.Key, val interface{}) {
+	if len(keys) == 0 || keys[0].String == nil {
+		return
+	}
+	if len(keys) == 1 {
+		setAttr(attrs, *keys[0].String, val)
+		return
+	}
+	current, ok := getOrInsertMap(attrs, *keys[0].String, keys[1])
+	if !ok {
+		return
+	}
+	SetIndexableValue(current, keys[1:], val)
+}
+
+// SetIndexableValue sets val into current at the location described by keys, following the same rules as SetMapValue.
+func SetIndexableValue(current pcommon.Value, keys []tql.Key, val interface{}) {
+	if len(keys) == 0 {
+		setValue(current, val)
+		return
+	}
+	for i := 0; i < len(keys)-1; i++ {
+		var ok bool
+		if keys[i].String != nil && current.Type() == pcommon.ValueTypeMap {
+			current, ok = getOrInsertMap(current.MapVal(), *keys[i].String, keys[i+1])
+		} else {
+			current, ok = index(current, keys[i])
+		}
+		if !ok {
+			return
+		}
+	}
+
+	last := keys[len(keys)-1]
+	switch {
+	case last.String != nil && current.Type() == pcommon.ValueTypeMap:
+		setAttr(current.MapVal(), *last.String, val)
+	case last.Int != nil:
+		if elem, ok := index(current, last); ok {
+			setValue(elem, val)
+		}
+	}
+}
+
+// index returns the value of a map or slice at key, if the type of val matches the type of key.
+func index(val pcommon.Value, key tql.Key) (pcommon.Value, bool) {
+	switch {
+	case key.String != nil && val.Type() == pcommon.ValueTypeMap:
+		return val.MapVal().Get(*key.String)
+	case key.Int != nil && val.Type() == pcommon.ValueTypeSlice:
+		i := *key.Int
+		if i < 0 || i >= int64(val.SliceVal().Len()) {
+			return pcommon.Value{}, false
+		}
+		return val.SliceVal().At(int(i)), true
+	}
+	return pcommon.Value{}, false
+}
+
+// getOrInsertMap returns the value of m at key. When it is missing and the next key is a map key, an empty map is
+// inserted and returned.
+func getOrInsertMap(m pcommon.Map, key string, next tql.Key) (pcommon.Value, bool) {
+	if val, ok := m.Get(key); ok {
+		return val, true
+	}
+	if next.String == nil {
+		return pcommon.Value{}, false
+	}
+	m.Insert(key, pcommon.NewValueMap())
+	return m.Get(key)
+}
+
+func getValue(val pcommon.Value) interface{} {
+	switch val.Type() {
+	case pcommon.ValueTypeString:
+		return val.StringVal()
+	case pcommon.ValueTypeBool:
+		return val.BoolVal()
+	case pcommon.ValueTypeInt:
+		return val.IntVal()
+	case pcommon.ValueTypeDouble:
+		return val.DoubleVal()
+	case pcommon.ValueTypeMap:
+		return val.MapVal()
+	case pcommon.ValueTypeSlice:
+		return val.SliceVal()
+	case pcommon.ValueTypeBytes:
+		return val.MBytesVal()
+	}
+	return nil
+}
+
+func setAttr(attrs pcommon.Map, mapKey string, val interface{}) {
+	switch v := val.(type) {
+	case string:
+		attrs.UpsertString(mapKey, v)
+	case bool:
+		attrs.UpsertBool(mapKey, v)
+	case int64:
+		attrs.UpsertInt(mapKey, v)
+	case float64:
+		attrs.UpsertDouble(mapKey, v)
+	case []byte:
+		attrs.UpsertBytes(mapKey, pcommon.NewImmutableByteSlice(v))
+	case []string:
+		arr := pcommon.NewValueSlice()
+		for _, str := range v {
+			arr.SliceVal().AppendEmpty().SetStringVal(str)
+		}
+		attrs.Upsert(mapKey, arr)
+	case []bool:
+		arr := pcommon.NewValueSlice()
+		for _, b := range v {
+			arr.SliceVal().AppendEmpty().SetBoolVal(b)
+		}
+		attrs.Upsert(mapKey, arr)
+	case []int64:
+		arr := pcommon.NewValueSlice()
+		for _, i := range v {
+			arr.SliceVal().AppendEmpty().SetIntVal(i)
+		}
+		attrs.Upsert(mapKey, arr)
+	case []float64:
+		arr := pcommon.NewValueSlice()
+		for _, f := range v {
+			arr.SliceVal().AppendEmpty().SetDoubleVal(f)
+		}
+		attrs.Upsert(mapKey, arr)
+	case [][]byte:
+		arr := pcommon.NewValueSlice()
+		for _, b := range v {
+			arr.SliceVal().AppendEmpty().SetBytesVal(pcommon.NewImmutableByteSlice(b))
+		}
+		attrs.Upsert(mapKey, arr)
+	case pcommon.Map:
+		m := pcommon.NewValueMap()
+		v.CopyTo(m.MapVal())
+		attrs.Upsert(mapKey, m)
+	case pcommon.Slice:
+		arr := pcommon.NewValueSlice()
+		v.CopyTo(arr.SliceVal())
+		attrs.Upsert(mapKey, arr)
+	}
+}
+
+func setValue(value pcommon.Value, val interface{}) {
+	switch v := val.(type) {
+	case string:
+		value.SetStringVal(v)
+	case bool:
+		value.SetBoolVal(v)
+	case int64:
+		value.SetIntVal(v)
+	case float64:
+		value.SetDoubleVal(v)
+	case []byte:
+		value.SetBytesVal(pcommon.NewImmutableByteSlice(v))
+	case []string:
+		value.SliceVal().RemoveIf(func(_ pcommon.Value) bool {
+			return true
+		})
+		for _, str := range v {
+			value.SliceVal().AppendEmpty().SetStringVal(str)
+		}
+	case []bool:
+		value.SliceVal().RemoveIf(func(_ pcommon.Value) bool {
+			return true
+		})
+		for _, b := range v {
+			value.SliceVal().AppendEmpty().SetBoolVal(b)
+		}
+	case []int64:
+		value.SliceVal().RemoveIf(func(_ pcommon.Value) bool {
+			return true
+		})
+		for _, i := range v {
+			value.SliceVal().AppendEmpty().SetIntVal(i)
+		}
+	case []float64:
+		value.SliceVal().RemoveIf(func(_ pcommon.Value) bool {
+			return true
+		})
+		for _, f := range v {
+			value.SliceVal().AppendEmpty().SetDoubleVal(f)
+		}
+	case [][]byte:
+		value.SliceVal().RemoveIf(func(_ pcommon.Value) bool {
+			return true
+		})
+		for _, b := range v {
+			value.SliceVal().AppendEmpty().SetBytesVal(pcommon.NewImmutableByteSlice(b))
+		}
+	case pcommon.Map:
+		m := pcommon.NewValueMap()
+		v.CopyTo(m.MapVal())
+		m.CopyTo(value)
+	case pcommon.Slice:
+		arr := pcommon.NewValueSlice()
+		v.CopyTo(arr.SliceVal())
+		arr.CopyTo(value)
+	}
+}
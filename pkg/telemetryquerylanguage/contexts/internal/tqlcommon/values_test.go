@@ -0,0 +1,264 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func strKey(s string) tql.Key {
+	return tql.Key{String: tqltest.Strp(s)}
+}
+
+func intKey(i int64) tql.Key {
+	return tql.Key{Int: tqltest.Intp(i)}
+}
+
+func createAttributes() pcommon.Map {
+	attrs := pcommon.NewMap()
+	attrs.UpsertString("str", "val")
+
+	tags := pcommon.NewValueSlice()
+	tags.SliceVal().AppendEmpty().SetStringVal("a")
+	tags.SliceVal().AppendEmpty().SetStringVal("b")
+	attrs.Upsert("tags", tags)
+
+	http := pcommon.NewValueMap()
+	http.MapVal().UpsertInt("status", 200)
+	attrs.Upsert("http", http)
+
+	object := pcommon.NewValueMap()
+	object.MapVal().UpsertString("name", "first")
+	objects := pcommon.NewValueSlice()
+	object.CopyTo(objects.SliceVal().AppendEmpty())
+	attrs.Upsert("objects", objects)
+
+	return attrs
+}
+
+func Test_GetMapValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []tql.Key
+		expected interface{}
+	}{
+		{
+			name:     "map key",
+			keys:     []tql.Key{strKey("str")},
+			expected: "val",
+		},
+		{
+			name:     "nested map key",
+			keys:     []tql.Key{strKey("http"), strKey("status")},
+			expected: int64(200),
+		},
+		{
+			name:     "slice index",
+			keys:     []tql.Key{strKey("tags"), intKey(1)},
+			expected: "b",
+		},
+		{
+			name:     "map in slice",
+			keys:     []tql.Key{strKey("objects"), intKey(0), strKey("name")},
+			expected: "first",
+		},
+		{
+			name:     "missing key",
+			keys:     []tql.Key{strKey("http"), strKey("method")},
+			expected: nil,
+		},
+		{
+			name:     "index out of range",
+			keys:     []tql.Key{strKey("tags"), intKey(2)},
+			expected: nil,
+		},
+		{
+			name:     "negative index",
+			keys:     []tql.Key{strKey("tags"), intKey(-1)},
+			expected: nil,
+		},
+		{
+			name:     "index into map",
+			keys:     []tql.Key{strKey("http"), intKey(0)},
+			expected: nil,
+		},
+		{
+			name:     "key into slice",
+			keys:     []tql.Key{strKey("tags"), strKey("a")},
+			expected: nil,
+		},
+		{
+			name:     "key into string",
+			keys:     []tql.Key{strKey("str"), strKey("a")},
+			expected: nil,
+		},
+		{
+			name:     "first key is an index",
+			keys:     []tql.Key{intKey(0)},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetMapValue(createAttributes(), tt.keys))
+		})
+	}
+}
+
+func Test_SetMapValue(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []tql.Key
+		val  interface{}
+		want func(pcommon.Map)
+	}{
+		{
+			name: "map key",
+			keys: []tql.Key{strKey("str")},
+			val:  "new",
+			want: func(attrs pcommon.Map) {
+				attrs.UpsertString("str", "new")
+			},
+		},
+		{
+			name: "nested map key",
+			keys: []tql.Key{strKey("http"), strKey("status")},
+			val:  int64(500),
+			want: func(attrs pcommon.Map) {
+				http, _ := attrs.Get("http")
+				http.MapVal().UpsertInt("status", 500)
+			},
+		},
+		{
+			name: "new nested map key",
+			keys: []tql.Key{strKey("http"), strKey("method")},
+			val:  "GET",
+			want: func(attrs pcommon.Map) {
+				http, _ := attrs.Get("http")
+				http.MapVal().UpsertString("method", "GET")
+			},
+		},
+		{
+			name: "slice index",
+			keys: []tql.Key{strKey("tags"), intKey(0)},
+			val:  "c",
+			want: func(attrs pcommon.Map) {
+				tags, _ := attrs.Get("tags")
+				tags.SliceVal().At(0).SetStringVal("c")
+			},
+		},
+		{
+			name: "map in slice",
+			keys: []tql.Key{strKey("objects"), intKey(0), strKey("name")},
+			val:  "second",
+			want: func(attrs pcommon.Map) {
+				objects, _ := attrs.Get("objects")
+				objects.SliceVal().At(0).MapVal().UpsertString("name", "second")
+			},
+		},
+		{
+			name: "create intermediate maps",
+			keys: []tql.Key{strKey("a"), strKey("b"), strKey("c")},
+			val:  true,
+			want: func(attrs pcommon.Map) {
+				a := pcommon.NewValueMap()
+				b := pcommon.NewValueMap()
+				b.MapVal().UpsertBool("c", true)
+				a.MapVal().Upsert("b", b)
+				attrs.Upsert("a", a)
+			},
+		},
+		{
+			name: "map value",
+			keys: []tql.Key{strKey("http"), strKey("headers")},
+			val: func() pcommon.Map {
+				headers := pcommon.NewMap()
+				headers.UpsertString("accept", "*/*")
+				return headers
+			}(),
+			want: func(attrs pcommon.Map) {
+				headers := pcommon.NewValueMap()
+				headers.MapVal().UpsertString("accept", "*/*")
+				http, _ := attrs.Get("http")
+				http.MapVal().Upsert("headers", headers)
+			},
+		},
+		{
+			name: "missing slice",
+			keys: []tql.Key{strKey("a"), intKey(0)},
+			val:  "c",
+			want: func(attrs pcommon.Map) {},
+		},
+		{
+			name: "index out of range",
+			keys: []tql.Key{strKey("tags"), intKey(2)},
+			val:  "c",
+			want: func(attrs pcommon.Map) {},
+		},
+		{
+			name: "key into string",
+			keys: []tql.Key{strKey("str"), strKey("a")},
+			val:  "c",
+			want: func(attrs pcommon.Map) {},
+		},
+		{
+			name: "first key is an index",
+			keys: []tql.Key{intKey(0)},
+			val:  "c",
+			want: func(attrs pcommon.Map) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := createAttributes()
+			SetMapValue(attrs, tt.keys, tt.val)
+
+			expected := createAttributes()
+			tt.want(expected)
+
+			assert.Equal(t, expected.Sort(), attrs.Sort())
+		})
+	}
+}
+
+func Test_GetIndexableValue(t *testing.T) {
+	body := pcommon.NewValueMap()
+	body.MapVal().UpsertString("message", "hello")
+
+	assert.Equal(t, body.MapVal(), GetIndexableValue(body, nil))
+	assert.Equal(t, "hello", GetIndexableValue(body, []tql.Key{strKey("message")}))
+	assert.Nil(t, GetIndexableValue(body, []tql.Key{strKey("missing")}))
+	assert.Nil(t, GetIndexableValue(pcommon.NewValueString("body"), []tql.Key{strKey("message")}))
+}
+
+func Test_SetIndexableValue(t *testing.T) {
+	body := pcommon.NewValueMap()
+	SetIndexableValue(body, []tql.Key{strKey("http"), strKey("status")}, int64(200))
+
+	expected := pcommon.NewValueMap()
+	http := pcommon.NewValueMap()
+	http.MapVal().UpsertInt("status", 200)
+	expected.MapVal().Upsert("http", http)
+	assert.Equal(t, expected, body)
+
+	SetIndexableValue(body, nil, "body")
+	assert.Equal(t, pcommon.NewValueString("body"), body)
+}
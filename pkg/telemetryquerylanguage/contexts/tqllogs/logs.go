@@ -0,0 +1,388 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:gocritic
+package tqllogs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqllogs"
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/internal/tqlcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// TransformContext is the context of the queries executed once per log record.
+type TransformContext struct {
+	log      plog.LogRecord
+	il       pcommon.InstrumentationScope
+	resource pcommon.Resource
+}
+
+func NewTransformContext(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource) TransformContext {
+	return TransformContext{
+		log:      log,
+		il:       il,
+		resource: resource,
+	}
+}
+
+func (ctx TransformContext) GetItem() interface{} {
+	return ctx.log
+}
+
+func (ctx TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return ctx.il
+}
+
+func (ctx TransformContext) GetResource() pcommon.Resource {
+	return ctx.resource
+}
+
+// pathGetSetter is a getSetter which has been resolved using a path expression provided by a user.
+type pathGetSetter struct {
+	getter tql.ExprFunc
+	setter func(ctx tql.TransformContext, val interface{})
+}
+
+func (path pathGetSetter) Get(ctx tql.TransformContext) interface{} {
+	return path.getter(ctx)
+}
+
+func (path pathGetSetter) Set(ctx tql.TransformContext, val interface{}) {
+	path.setter(ctx, val)
+}
+
+func ParsePath(val *tql.Path) (tql.GetSetter, error) {
+	if val != nil && len(val.Fields) > 0 {
+		return newPathGetSetter(val.Fields)
+	}
+	return nil, fmt.Errorf("bad path %v", val)
+}
+
+func newPathGetSetter(path []tql.Field) (tql.GetSetter, error) {
+	switch path[0].Name {
+	case "resource":
+		if len(path) == 1 {
+			return accessResource(), nil
+		}
+		switch path[1].Name {
+		case "attributes":
+			keys := path[1].Keys
+			if len(keys) == 0 {
+				return accessResourceAttributes(), nil
+			}
+			return accessResourceAttributesKey(keys), nil
+		}
+	case "instrumentation_scope":
+		if len(path) == 1 {
+			return accessInstrumentationScope(), nil
+		}
+		switch path[1].Name {
+		case "name":
+			return accessInstrumentationScopeName(), nil
+		case "version":
+			return accessInstrumentationScopeVersion(), nil
+		}
+	case "time_unix_nano":
+		return accessTimeUnixNano(), nil
+	case "observed_time_unix_nano":
+		return accessObservedTimeUnixNano(), nil
+	case "severity_number":
+		return accessSeverityNumber(), nil
+	case "severity_text":
+		return accessSeverityText(), nil
+	case "body":
+		return accessBody(path[0].Keys), nil
+	case "attributes":
+		keys := path[0].Keys
+		if len(keys) == 0 {
+			return accessAttributes(), nil
+		}
+		return accessAttributesKey(keys), nil
+	case "dropped_attributes_count":
+		return accessDroppedAttributesCount(), nil
+	case "flags":
+		return accessFlags(), nil
+	case "trace_id":
+		if len(path) == 1 {
+			return accessTraceID(), nil
+		}
+		switch path[1].Name {
+		case "string":
+			return accessStringTraceID(), nil
+		}
+	case "span_id":
+		if len(path) == 1 {
+			return accessSpanID(), nil
+		}
+		switch path[1].Name {
+		case "string":
+			return accessStringSpanID(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid path expression %v", path)
+}
+
+func accessResource() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetResource()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if newRes, ok := val.(pcommon.Resource); ok {
+				ctx.GetResource().Attributes().Clear()
+				newRes.CopyTo(ctx.GetResource())
+			}
+		},
+	}
+}
+
+func accessResourceAttributes() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetResource().Attributes()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if attrs, ok := val.(pcommon.Map); ok {
+				ctx.GetResource().Attributes().Clear()
+				attrs.CopyTo(ctx.GetResource().Attributes())
+			}
+		},
+	}
+}
+
+func accessResourceAttributesKey(keys []tql.Key) pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetResource().Attributes(), keys)
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetResource().Attributes(), keys, val)
+		},
+	}
+}
+
+func accessInstrumentationScope() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetInstrumentationScope()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if newIl, ok := val.(pcommon.InstrumentationScope); ok {
+				newIl.CopyTo(ctx.GetInstrumentationScope())
+			}
+		},
+	}
+}
+
+func accessInstrumentationScopeName() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetInstrumentationScope().Name()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				ctx.GetInstrumentationScope().SetName(str)
+			}
+		},
+	}
+}
+
+func accessInstrumentationScopeVersion() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetInstrumentationScope().Version()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				ctx.GetInstrumentationScope().SetVersion(str)
+			}
+		},
+	}
+}
+
+func accessTimeUnixNano() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).Timestamp().AsTime().UnixNano()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(plog.LogRecord).SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
+			}
+		},
+	}
+}
+
+func accessObservedTimeUnixNano() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).ObservedTimestamp().AsTime().UnixNano()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(plog.LogRecord).SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
+			}
+		},
+	}
+}
+
+func accessSeverityNumber() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetItem().(plog.LogRecord).SeverityNumber())
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(plog.LogRecord).SetSeverityNumber(plog.SeverityNumber(i))
+			}
+		},
+	}
+}
+
+func accessSeverityText() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).SeverityText()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if s, ok := val.(string); ok {
+				ctx.GetItem().(plog.LogRecord).SetSeverityText(s)
+			}
+		},
+	}
+}
+
+func accessBody(keys []tql.Key) pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetIndexableValue(ctx.GetItem().(plog.LogRecord).Body(), keys)
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetIndexableValue(ctx.GetItem().(plog.LogRecord).Body(), keys, val)
+		},
+	}
+}
+
+func accessAttributes() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).Attributes()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if attrs, ok := val.(pcommon.Map); ok {
+				ctx.GetItem().(plog.LogRecord).Attributes().Clear()
+				attrs.CopyTo(ctx.GetItem().(plog.LogRecord).Attributes())
+			}
+		},
+	}
+}
+
+func accessAttributesKey(keys []tql.Key) pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetItem().(plog.LogRecord).Attributes(), keys)
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetItem().(plog.LogRecord).Attributes(), keys, val)
+		},
+	}
+}
+
+func accessDroppedAttributesCount() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetItem().(plog.LogRecord).DroppedAttributesCount())
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(plog.LogRecord).SetDroppedAttributesCount(uint32(i))
+			}
+		},
+	}
+}
+
+func accessFlags() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetItem().(plog.LogRecord).Flags())
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(plog.LogRecord).SetFlags(uint32(i))
+			}
+		},
+	}
+}
+
+func accessTraceID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).TraceID()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if newTraceID, ok := val.(pcommon.TraceID); ok {
+				ctx.GetItem().(plog.LogRecord).SetTraceID(newTraceID)
+			}
+		},
+	}
+}
+
+func accessStringTraceID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).TraceID().HexString()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				if traceID, err := tqlcommon.ParseTraceID(str); err == nil {
+					ctx.GetItem().(plog.LogRecord).SetTraceID(traceID)
+				}
+			}
+		},
+	}
+}
+
+func accessSpanID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).SpanID()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if newSpanID, ok := val.(pcommon.SpanID); ok {
+				ctx.GetItem().(plog.LogRecord).SetSpanID(newSpanID)
+			}
+		},
+	}
+}
+
+func accessStringSpanID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(plog.LogRecord).SpanID().HexString()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				if spanID, err := tqlcommon.ParseSpanID(str); err == nil {
+					ctx.GetItem().(plog.LogRecord).SetSpanID(spanID)
+				}
+			}
+		},
+	}
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package logs
+package tqllogs
 
 import (
 	"encoding/hex"
@@ -23,8 +23,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 var (
@@ -57,14 +57,14 @@ func Test_newPathGetSetter(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		path     []common.Field
+		path     []tql.Field
 		orig     interface{}
 		new      interface{}
 		modified func(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource)
 	}{
 		{
 			name: "time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "time_unix_nano",
 				},
@@ -77,7 +77,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "observed_time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "observed_time_unix_nano",
 				},
@@ -90,12 +90,12 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "severity_number",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "severity_number",
 				},
 			},
-			orig: plog.SeverityNumberFATAL,
+			orig: int64(plog.SeverityNumberFATAL),
 			new:  int64(3),
 			modified: func(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource) {
 				log.SetSeverityNumber(plog.SeverityNumberTRACE3)
@@ -103,7 +103,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "severity_text",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "severity_text",
 				},
@@ -116,7 +116,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "body",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "body",
 				},
@@ -129,7 +129,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "flags",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "flags",
 				},
@@ -142,7 +142,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "trace_id",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "trace_id",
 				},
@@ -155,7 +155,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "span_id",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "span_id",
 				},
@@ -168,7 +168,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "trace_id string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "trace_id",
 				},
@@ -184,7 +184,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "span_id string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "span_id",
 				},
@@ -200,7 +200,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "attributes",
 				},
@@ -214,10 +214,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -228,10 +232,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -242,10 +250,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -256,10 +268,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: float64(1.2),
@@ -270,10 +286,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -284,10 +304,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -301,10 +325,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -318,10 +346,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -335,10 +367,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -352,10 +388,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -367,9 +407,76 @@ func Test_newPathGetSetter(t *testing.T) {
 				log.Attributes().Upsert("arr_bytes", newArrBytes)
 			},
 		},
+		{
+			name: "attributes array index",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+						{
+							Int: tqltest.Intp(1),
+						},
+					},
+				},
+			},
+			orig: "two",
+			new:  "new",
+			modified: func(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				val, _ := log.Attributes().Get("arr_str")
+				val.SliceVal().At(1).SetStringVal("new")
+			},
+		},
+		{
+			name: "attributes nested map",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("map"),
+						},
+						{
+							String: tqltest.Strp("key"),
+						},
+					},
+				},
+			},
+			orig: "val",
+			new:  "new",
+			modified: func(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				val, _ := log.Attributes().Get("map")
+				val.MapVal().UpsertString("key", "new")
+			},
+		},
+		{
+			name: "attributes new nested map",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("new_map"),
+						},
+						{
+							String: tqltest.Strp("key"),
+						},
+					},
+				},
+			},
+			orig: nil,
+			new:  "new",
+			modified: func(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				newMap := pcommon.NewValueMap()
+				newMap.MapVal().UpsertString("key", "new")
+				log.Attributes().Upsert("new_map", newMap)
+			},
+		},
 		{
 			name: "dropped_attributes_count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "dropped_attributes_count",
 				},
@@ -382,7 +489,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "instrumentation_scope name",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "instrumentation_scope",
 				},
@@ -398,7 +505,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "instrumentation_scope version",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "instrumentation_scope",
 				},
@@ -414,7 +521,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
@@ -431,13 +538,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -448,13 +559,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -465,13 +580,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -482,13 +601,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: float64(1.2),
@@ -499,13 +622,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -516,13 +643,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -536,13 +667,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -556,13 +691,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -576,13 +715,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -596,13 +739,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -622,14 +769,14 @@ func Test_newPathGetSetter(t *testing.T) {
 
 			log, il, resource := createTelemetry()
 
-			got := accessor.Get(logTransformContext{
+			got := accessor.Get(TransformContext{
 				log:      log,
 				il:       il,
 				resource: resource,
 			})
 			assert.Equal(t, tt.orig, got)
 
-			accessor.Set(logTransformContext{
+			accessor.Set(TransformContext{
 				log:      log,
 				il:       il,
 				resource: resource,
@@ -645,6 +792,45 @@ func Test_newPathGetSetter(t *testing.T) {
 	}
 }
 
+func Test_newPathGetSetter_BodyKeys(t *testing.T) {
+	accessor, err := newPathGetSetter([]tql.Field{
+		{
+			Name: "body",
+			Keys: []tql.Key{
+				{
+					String: tqltest.Strp("http"),
+				},
+				{
+					String: tqltest.Strp("status"),
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	log, il, resource := createTelemetry()
+	ctx := TransformContext{
+		log:      log,
+		il:       il,
+		resource: resource,
+	}
+
+	// a string body can't be indexed
+	assert.Nil(t, accessor.Get(ctx))
+	accessor.Set(ctx, int64(200))
+	assert.Equal(t, "body", log.Body().StringVal())
+
+	pcommon.NewValueMap().CopyTo(log.Body())
+	accessor.Set(ctx, int64(200))
+	assert.Equal(t, int64(200), accessor.Get(ctx))
+
+	http, ok := log.Body().MapVal().Get("http")
+	assert.True(t, ok)
+	status, ok := http.MapVal().Get("status")
+	assert.True(t, ok)
+	assert.Equal(t, int64(200), status.IntVal())
+}
+
 func createTelemetry() (plog.LogRecord, pcommon.InstrumentationScope, pcommon.Resource) {
 	log := plog.NewLogRecord()
 	log.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(100)))
@@ -683,6 +869,10 @@ func createTelemetry() (plog.LogRecord, pcommon.InstrumentationScope, pcommon.Re
 	arrBytes.SliceVal().AppendEmpty().SetBytesVal(pcommon.NewImmutableByteSlice([]byte{2, 3, 4}))
 	log.Attributes().Upsert("arr_bytes", arrBytes)
 
+	m := pcommon.NewValueMap()
+	m.MapVal().UpsertString("key", "val")
+	log.Attributes().Upsert("map", m)
+
 	log.SetDroppedAttributesCount(10)
 
 	log.SetFlags(uint32(3))
@@ -13,7 +13,7 @@
 // limitations under the License.
 
 // nolint:gocritic
-package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metrics"
+package tqlmetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlmetrics"
 import (
 	"fmt"
 	"time"
@@ -21,10 +21,13 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/internal/tqlcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
-type metricTransformContext struct {
+// TransformContext is the context of the queries executed once per data point. The metric the data point belongs
+// to, and the slice of metrics the metric is in, are available to the functions working on whole metrics.
+type TransformContext struct {
 	dataPoint interface{}
 	metric    pmetric.Metric
 	metrics   pmetric.MetricSlice
@@ -32,48 +35,58 @@ type metricTransformContext struct {
 	resource  pcommon.Resource
 }
 
-func (ctx metricTransformContext) GetItem() interface{} {
+func NewTransformContext(dataPoint interface{}, metric pmetric.Metric, metrics pmetric.MetricSlice, il pcommon.InstrumentationScope, resource pcommon.Resource) TransformContext {
+	return TransformContext{
+		dataPoint: dataPoint,
+		metric:    metric,
+		metrics:   metrics,
+		il:        il,
+		resource:  resource,
+	}
+}
+
+func (ctx TransformContext) GetItem() interface{} {
 	return ctx.dataPoint
 }
 
-func (ctx metricTransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+func (ctx TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
 	return ctx.il
 }
 
-func (ctx metricTransformContext) GetResource() pcommon.Resource {
+func (ctx TransformContext) GetResource() pcommon.Resource {
 	return ctx.resource
 }
 
-func (ctx metricTransformContext) GetMetric() pmetric.Metric {
+func (ctx TransformContext) GetMetric() pmetric.Metric {
 	return ctx.metric
 }
 
-func (ctx metricTransformContext) GetMetrics() pmetric.MetricSlice {
+func (ctx TransformContext) GetMetrics() pmetric.MetricSlice {
 	return ctx.metrics
 }
 
 // pathGetSetter is a getSetter which has been resolved using a path expression provided by a user.
 type pathGetSetter struct {
-	getter common.ExprFunc
-	setter func(ctx common.TransformContext, val interface{})
+	getter tql.ExprFunc
+	setter func(ctx tql.TransformContext, val interface{})
 }
 
-func (path pathGetSetter) Get(ctx common.TransformContext) interface{} {
+func (path pathGetSetter) Get(ctx tql.TransformContext) interface{} {
 	return path.getter(ctx)
 }
 
-func (path pathGetSetter) Set(ctx common.TransformContext, val interface{}) {
+func (path pathGetSetter) Set(ctx tql.TransformContext, val interface{}) {
 	path.setter(ctx, val)
 }
 
-func ParsePath(val *common.Path) (common.GetSetter, error) {
+func ParsePath(val *tql.Path) (tql.GetSetter, error) {
 	if val != nil && len(val.Fields) > 0 {
 		return newPathGetSetter(val.Fields)
 	}
 	return nil, fmt.Errorf("bad path %v", val)
 }
 
-func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
+func newPathGetSetter(path []tql.Field) (tql.GetSetter, error) {
 	switch path[0].Name {
 	case "resource":
 		if len(path) == 1 {
@@ -81,11 +94,11 @@ func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
 		}
 		switch path[1].Name {
 		case "attributes":
-			mapKey := path[1].MapKey
-			if mapKey == nil {
+			keys := path[1].Keys
+			if len(keys) == 0 {
 				return accessResourceAttributes(), nil
 			}
-			return accessResourceAttributesKey(mapKey), nil
+			return accessResourceAttributesKey(keys), nil
 		}
 	case "instrumentation_scope":
 		if len(path) == 1 {
@@ -116,11 +129,11 @@ func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
 			return accessMetricIsMonotonic(), nil
 		}
 	case "attributes":
-		mapKey := path[0].MapKey
-		if mapKey == nil {
+		keys := path[0].Keys
+		if len(keys) == 0 {
 			return accessAttributes(), nil
 		}
-		return accessAttributesKey(mapKey), nil
+		return accessAttributesKey(keys), nil
 	case "start_time_unix_nano":
 		return accessStartTimeUnixNano(), nil
 	case "time_unix_nano":
@@ -173,10 +186,10 @@ func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
 
 func accessResource() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetResource()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newRes, ok := val.(pcommon.Resource); ok {
 				ctx.GetResource().Attributes().Clear()
 				newRes.CopyTo(ctx.GetResource())
@@ -187,10 +200,10 @@ func accessResource() pathGetSetter {
 
 func accessResourceAttributes() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetResource().Attributes()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if attrs, ok := val.(pcommon.Map); ok {
 				ctx.GetResource().Attributes().Clear()
 				attrs.CopyTo(ctx.GetResource().Attributes())
@@ -199,23 +212,23 @@ func accessResourceAttributes() pathGetSetter {
 	}
 }
 
-func accessResourceAttributesKey(mapKey *string) pathGetSetter {
+func accessResourceAttributesKey(keys []tql.Key) pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return getAttr(ctx.GetResource().Attributes(), *mapKey)
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetResource().Attributes(), keys)
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
-			setAttr(ctx.GetResource().Attributes(), *mapKey, val)
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetResource().Attributes(), keys, val)
 		},
 	}
 }
 
 func accessInstrumentationScope() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetInstrumentationScope()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newIl, ok := val.(pcommon.InstrumentationScope); ok {
 				newIl.CopyTo(ctx.GetInstrumentationScope())
 			}
@@ -225,10 +238,10 @@ func accessInstrumentationScope() pathGetSetter {
 
 func accessInstrumentationScopeName() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetInstrumentationScope().Name()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				ctx.GetInstrumentationScope().SetName(str)
 			}
@@ -238,10 +251,10 @@ func accessInstrumentationScopeName() pathGetSetter {
 
 func accessInstrumentationScopeVersion() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetInstrumentationScope().Version()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				ctx.GetInstrumentationScope().SetVersion(str)
 			}
@@ -251,12 +264,12 @@ func accessInstrumentationScopeVersion() pathGetSetter {
 
 func accessMetric() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return ctx.(metricTransformContext).GetMetric()
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.(TransformContext).GetMetric()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newMetric, ok := val.(pmetric.Metric); ok {
-				newMetric.CopyTo(ctx.(metricTransformContext).GetMetric())
+				newMetric.CopyTo(ctx.(TransformContext).GetMetric())
 			}
 		},
 	}
@@ -264,12 +277,12 @@ func accessMetric() pathGetSetter {
 
 func accessMetricName() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return ctx.(metricTransformContext).GetMetric().Name()
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.(TransformContext).GetMetric().Name()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
-				ctx.(metricTransformContext).GetMetric().SetName(str)
+				ctx.(TransformContext).GetMetric().SetName(str)
 			}
 		},
 	}
@@ -277,12 +290,12 @@ func accessMetricName() pathGetSetter {
 
 func accessMetricDescription() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return ctx.(metricTransformContext).GetMetric().Description()
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.(TransformContext).GetMetric().Description()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
-				ctx.(metricTransformContext).GetMetric().SetDescription(str)
+				ctx.(TransformContext).GetMetric().SetDescription(str)
 			}
 		},
 	}
@@ -290,12 +303,12 @@ func accessMetricDescription() pathGetSetter {
 
 func accessMetricUnit() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return ctx.(metricTransformContext).GetMetric().Unit()
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.(TransformContext).GetMetric().Unit()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
-				ctx.(metricTransformContext).GetMetric().SetUnit(str)
+				ctx.(TransformContext).GetMetric().SetUnit(str)
 			}
 		},
 	}
@@ -303,10 +316,10 @@ func accessMetricUnit() pathGetSetter {
 
 func accessMetricType() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return ctx.(metricTransformContext).GetMetric().DataType().String()
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.(TransformContext).GetMetric().DataType().String()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			// TODO Implement methods so correctly convert data types.
 			// https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/10130
 		},
@@ -315,8 +328,8 @@ func accessMetricType() pathGetSetter {
 
 func accessMetricAggTemporality() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			metric := ctx.(metricTransformContext).GetMetric()
+		getter: func(ctx tql.TransformContext) interface{} {
+			metric := ctx.(TransformContext).GetMetric()
 			switch metric.DataType() {
 			case pmetric.MetricDataTypeSum:
 				return int64(metric.Sum().AggregationTemporality())
@@ -327,9 +340,9 @@ func accessMetricAggTemporality() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newAggTemporality, ok := val.(int64); ok {
-				metric := ctx.(metricTransformContext).GetMetric()
+				metric := ctx.(TransformContext).GetMetric()
 				switch metric.DataType() {
 				case pmetric.MetricDataTypeSum:
 					metric.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporality(newAggTemporality))
@@ -345,17 +358,17 @@ func accessMetricAggTemporality() pathGetSetter {
 
 func accessMetricIsMonotonic() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			metric := ctx.(metricTransformContext).GetMetric()
+		getter: func(ctx tql.TransformContext) interface{} {
+			metric := ctx.(TransformContext).GetMetric()
 			switch metric.DataType() {
 			case pmetric.MetricDataTypeSum:
 				return metric.Sum().IsMonotonic()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newIsMonotonic, ok := val.(bool); ok {
-				metric := ctx.(metricTransformContext).GetMetric()
+				metric := ctx.(TransformContext).GetMetric()
 				switch metric.DataType() {
 				case pmetric.MetricDataTypeSum:
 					metric.Sum().SetIsMonotonic(newIsMonotonic)
@@ -367,7 +380,7 @@ func accessMetricIsMonotonic() pathGetSetter {
 
 func accessAttributes() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				return ctx.GetItem().(pmetric.NumberDataPoint).Attributes()
@@ -380,7 +393,7 @@ func accessAttributes() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				if attrs, ok := val.(pcommon.Map); ok {
@@ -407,31 +420,31 @@ func accessAttributes() pathGetSetter {
 	}
 }
 
-func accessAttributesKey(mapKey *string) pathGetSetter {
+func accessAttributesKey(keys []tql.Key) pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
-				return getAttr(ctx.GetItem().(pmetric.NumberDataPoint).Attributes(), *mapKey)
+				return tqlcommon.GetMapValue(ctx.GetItem().(pmetric.NumberDataPoint).Attributes(), keys)
 			case pmetric.HistogramDataPoint:
-				return getAttr(ctx.GetItem().(pmetric.HistogramDataPoint).Attributes(), *mapKey)
+				return tqlcommon.GetMapValue(ctx.GetItem().(pmetric.HistogramDataPoint).Attributes(), keys)
 			case pmetric.ExponentialHistogramDataPoint:
-				return getAttr(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Attributes(), *mapKey)
+				return tqlcommon.GetMapValue(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Attributes(), keys)
 			case pmetric.SummaryDataPoint:
-				return getAttr(ctx.GetItem().(pmetric.SummaryDataPoint).Attributes(), *mapKey)
+				return tqlcommon.GetMapValue(ctx.GetItem().(pmetric.SummaryDataPoint).Attributes(), keys)
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
-				setAttr(ctx.GetItem().(pmetric.NumberDataPoint).Attributes(), *mapKey, val)
+				tqlcommon.SetMapValue(ctx.GetItem().(pmetric.NumberDataPoint).Attributes(), keys, val)
 			case pmetric.HistogramDataPoint:
-				setAttr(ctx.GetItem().(pmetric.HistogramDataPoint).Attributes(), *mapKey, val)
+				tqlcommon.SetMapValue(ctx.GetItem().(pmetric.HistogramDataPoint).Attributes(), keys, val)
 			case pmetric.ExponentialHistogramDataPoint:
-				setAttr(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Attributes(), *mapKey, val)
+				tqlcommon.SetMapValue(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Attributes(), keys, val)
 			case pmetric.SummaryDataPoint:
-				setAttr(ctx.GetItem().(pmetric.SummaryDataPoint).Attributes(), *mapKey, val)
+				tqlcommon.SetMapValue(ctx.GetItem().(pmetric.SummaryDataPoint).Attributes(), keys, val)
 			}
 		},
 	}
@@ -439,7 +452,7 @@ func accessAttributesKey(mapKey *string) pathGetSetter {
 
 func accessStartTimeUnixNano() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				return ctx.GetItem().(pmetric.NumberDataPoint).StartTimestamp().AsTime().UnixNano()
@@ -452,7 +465,7 @@ func accessStartTimeUnixNano() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newTime, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.NumberDataPoint:
@@ -471,7 +484,7 @@ func accessStartTimeUnixNano() pathGetSetter {
 
 func accessTimeUnixNano() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				return ctx.GetItem().(pmetric.NumberDataPoint).Timestamp().AsTime().UnixNano()
@@ -484,7 +497,7 @@ func accessTimeUnixNano() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newTime, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.NumberDataPoint:
@@ -503,14 +516,14 @@ func accessTimeUnixNano() pathGetSetter {
 
 func accessDoubleValue() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				return ctx.GetItem().(pmetric.NumberDataPoint).DoubleVal()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newDouble, ok := val.(float64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.NumberDataPoint:
@@ -523,14 +536,14 @@ func accessDoubleValue() pathGetSetter {
 
 func accessIntValue() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				return ctx.GetItem().(pmetric.NumberDataPoint).IntVal()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newInt, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.NumberDataPoint:
@@ -543,7 +556,7 @@ func accessIntValue() pathGetSetter {
 
 func accessExemplars() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				return ctx.GetItem().(pmetric.NumberDataPoint).Exemplars()
@@ -554,7 +567,7 @@ func accessExemplars() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newExemplars, ok := val.(pmetric.ExemplarSlice); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.NumberDataPoint:
@@ -571,7 +584,7 @@ func accessExemplars() pathGetSetter {
 
 func accessFlags() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.NumberDataPoint:
 				return ctx.GetItem().(pmetric.NumberDataPoint).Flags()
@@ -584,7 +597,7 @@ func accessFlags() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newFlags, ok := val.(pmetric.MetricDataPointFlags); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.NumberDataPoint:
@@ -603,7 +616,7 @@ func accessFlags() pathGetSetter {
 
 func accessCount() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.HistogramDataPoint:
 				return int64(ctx.GetItem().(pmetric.HistogramDataPoint).Count())
@@ -614,7 +627,7 @@ func accessCount() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newCount, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.HistogramDataPoint:
@@ -631,7 +644,7 @@ func accessCount() pathGetSetter {
 
 func accessSum() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.HistogramDataPoint:
 				return ctx.GetItem().(pmetric.HistogramDataPoint).Sum()
@@ -642,7 +655,7 @@ func accessSum() pathGetSetter {
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newSum, ok := val.(float64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.HistogramDataPoint:
@@ -659,14 +672,14 @@ func accessSum() pathGetSetter {
 
 func accessExplicitBounds() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.HistogramDataPoint:
 				return ctx.GetItem().(pmetric.HistogramDataPoint).MExplicitBounds()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newExplicitBounds, ok := val.([]float64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.HistogramDataPoint:
@@ -679,14 +692,14 @@ func accessExplicitBounds() pathGetSetter {
 
 func accessBucketCounts() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.HistogramDataPoint:
 				return ctx.GetItem().(pmetric.HistogramDataPoint).MBucketCounts()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newBucketCount, ok := val.([]uint64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.HistogramDataPoint:
@@ -699,14 +712,14 @@ func accessBucketCounts() pathGetSetter {
 
 func accessScale() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return int64(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Scale())
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newScale, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -719,14 +732,14 @@ func accessScale() pathGetSetter {
 
 func accessZeroCount() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return int64(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).ZeroCount())
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newZeroCount, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -739,14 +752,14 @@ func accessZeroCount() pathGetSetter {
 
 func accessPositive() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Positive()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newPositive, ok := val.(pmetric.Buckets); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -759,14 +772,14 @@ func accessPositive() pathGetSetter {
 
 func accessPositiveOffset() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return int64(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Positive().Offset())
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newPositiveOffset, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -779,14 +792,14 @@ func accessPositiveOffset() pathGetSetter {
 
 func accessPositiveBucketCounts() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Positive().MBucketCounts()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newPositiveBucketCounts, ok := val.([]uint64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -799,14 +812,14 @@ func accessPositiveBucketCounts() pathGetSetter {
 
 func accessNegative() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Negative()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newNegative, ok := val.(pmetric.Buckets); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -819,14 +832,14 @@ func accessNegative() pathGetSetter {
 
 func accessNegativeOffset() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return int64(ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Negative().Offset())
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newNegativeOffset, ok := val.(int64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -839,14 +852,14 @@ func accessNegativeOffset() pathGetSetter {
 
 func accessNegativeBucketCounts() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.ExponentialHistogramDataPoint:
 				return ctx.GetItem().(pmetric.ExponentialHistogramDataPoint).Negative().MBucketCounts()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newNegativeBucketCounts, ok := val.([]uint64); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.ExponentialHistogramDataPoint:
@@ -859,14 +872,14 @@ func accessNegativeBucketCounts() pathGetSetter {
 
 func accessQuantileValues() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			switch ctx.GetItem().(type) {
 			case pmetric.SummaryDataPoint:
 				return ctx.GetItem().(pmetric.SummaryDataPoint).QuantileValues()
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newQuantileValues, ok := val.(pmetric.ValueAtQuantileSlice); ok {
 				switch ctx.GetItem().(type) {
 				case pmetric.SummaryDataPoint:
@@ -876,78 +889,3 @@ func accessQuantileValues() pathGetSetter {
 		},
 	}
 }
-
-func getAttr(attrs pcommon.Map, mapKey string) interface{} {
-	val, ok := attrs.Get(mapKey)
-	if !ok {
-		return nil
-	}
-	return getValue(val)
-}
-
-func getValue(val pcommon.Value) interface{} {
-	switch val.Type() {
-	case pcommon.ValueTypeString:
-		return val.StringVal()
-	case pcommon.ValueTypeBool:
-		return val.BoolVal()
-	case pcommon.ValueTypeInt:
-		return val.IntVal()
-	case pcommon.ValueTypeDouble:
-		return val.DoubleVal()
-	case pcommon.ValueTypeMap:
-		return val.MapVal()
-	case pcommon.ValueTypeSlice:
-		return val.SliceVal()
-	case pcommon.ValueTypeBytes:
-		return val.MBytesVal()
-	}
-	return nil
-}
-
-func setAttr(attrs pcommon.Map, mapKey string, val interface{}) {
-	switch v := val.(type) {
-	case string:
-		attrs.UpsertString(mapKey, v)
-	case bool:
-		attrs.UpsertBool(mapKey, v)
-	case int64:
-		attrs.UpsertInt(mapKey, v)
-	case float64:
-		attrs.UpsertDouble(mapKey, v)
-	case []byte:
-		attrs.UpsertBytes(mapKey, pcommon.NewImmutableByteSlice(v))
-	case []string:
-		arr := pcommon.NewValueSlice()
-		for _, str := range v {
-			arr.SliceVal().AppendEmpty().SetStringVal(str)
-		}
-		attrs.Upsert(mapKey, arr)
-	case []bool:
-		arr := pcommon.NewValueSlice()
-		for _, b := range v {
-			arr.SliceVal().AppendEmpty().SetBoolVal(b)
-		}
-		attrs.Upsert(mapKey, arr)
-	case []int64:
-		arr := pcommon.NewValueSlice()
-		for _, i := range v {
-			arr.SliceVal().AppendEmpty().SetIntVal(i)
-		}
-		attrs.Upsert(mapKey, arr)
-	case []float64:
-		arr := pcommon.NewValueSlice()
-		for _, f := range v {
-			arr.SliceVal().AppendEmpty().SetDoubleVal(f)
-		}
-		attrs.Upsert(mapKey, arr)
-	case [][]byte:
-		arr := pcommon.NewValueSlice()
-		for _, b := range v {
-			arr.SliceVal().AppendEmpty().SetBytesVal(pcommon.NewImmutableByteSlice(b))
-		}
-		attrs.Upsert(mapKey, arr)
-	default:
-		// TODO(anuraaga): Support set of map type.
-	}
-}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metrics"
+package tqlmetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlmetrics"
 import (
 	"testing"
 	"time"
@@ -21,8 +21,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
@@ -32,7 +32,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 
 	tests := []struct {
 		name      string
-		path      []common.Field
+		path      []tql.Field
 		orig      interface{}
 		new       interface{}
 		modified  func(pmetric.NumberDataPoint)
@@ -40,7 +40,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 	}{
 		{
 			name: "start_time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "start_time_unix_nano",
 				},
@@ -53,7 +53,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "time_unix_nano",
 				},
@@ -66,7 +66,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "value_double",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "value_double",
 				},
@@ -80,7 +80,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "value_int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "value_int",
 				},
@@ -93,7 +93,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "flags",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "flags",
 				},
@@ -106,7 +106,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "exemplars",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "exemplars",
 				},
@@ -119,7 +119,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "attributes",
 				},
@@ -133,10 +133,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -147,10 +151,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -161,10 +169,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -175,10 +187,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: float64(1.2),
@@ -189,10 +205,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -203,10 +223,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -220,10 +244,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -237,10 +265,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -254,10 +286,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -271,10 +307,14 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -286,6 +326,28 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 				datapoint.Attributes().Upsert("arr_bytes", newArrBytes)
 			},
 		},
+		{
+			name: "attributes array index",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+						{
+							Int: tqltest.Intp(1),
+						},
+					},
+				},
+			},
+			orig: int64(3),
+			new:  int64(4),
+			modified: func(datapoint pmetric.NumberDataPoint) {
+				val, _ := datapoint.Attributes().Get("arr_int")
+				val.SliceVal().At(1).SetIntVal(4)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -294,7 +356,7 @@ func Test_newPathGetSetter_NumberDataPoint(t *testing.T) {
 
 			numberDataPoint := createNumberDataPointTelemetry(tt.valueType)
 
-			ctx := metricTransformContext{
+			ctx := TransformContext{
 				dataPoint: numberDataPoint,
 				metric:    pmetric.NewMetric(),
 				il:        pcommon.NewInstrumentationScope(),
@@ -340,14 +402,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		path     []common.Field
+		path     []tql.Field
 		orig     interface{}
 		new      interface{}
 		modified func(pmetric.HistogramDataPoint)
 	}{
 		{
 			name: "start_time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "start_time_unix_nano",
 				},
@@ -360,7 +422,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "time_unix_nano",
 				},
@@ -373,7 +435,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "flags",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "flags",
 				},
@@ -386,7 +448,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "count",
 				},
@@ -399,7 +461,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "sum",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "sum",
 				},
@@ -412,7 +474,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "bucket_counts",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "bucket_counts",
 				},
@@ -425,7 +487,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "explicit_bounds",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "explicit_bounds",
 				},
@@ -438,7 +500,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "exemplars",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "exemplars",
 				},
@@ -451,7 +513,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "attributes",
 				},
@@ -465,10 +527,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -479,10 +545,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -493,10 +563,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -507,10 +581,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: float64(1.2),
@@ -521,10 +599,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -535,10 +617,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -552,10 +638,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -569,10 +659,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -586,10 +680,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -603,10 +701,14 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -626,7 +728,7 @@ func Test_newPathGetSetter_HistogramDataPoint(t *testing.T) {
 
 			numberDataPoint := createHistogramDataPointTelemetry()
 
-			ctx := metricTransformContext{
+			ctx := TransformContext{
 				dataPoint: numberDataPoint,
 				metric:    pmetric.NewMetric(),
 				il:        pcommon.NewInstrumentationScope(),
@@ -678,14 +780,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		path     []common.Field
+		path     []tql.Field
 		orig     interface{}
 		new      interface{}
 		modified func(pmetric.ExponentialHistogramDataPoint)
 	}{
 		{
 			name: "start_time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "start_time_unix_nano",
 				},
@@ -698,7 +800,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "time_unix_nano",
 				},
@@ -711,7 +813,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "flags",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "flags",
 				},
@@ -724,7 +826,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "count",
 				},
@@ -737,7 +839,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "sum",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "sum",
 				},
@@ -750,7 +852,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "scale",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "scale",
 				},
@@ -763,7 +865,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "zero_count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "zero_count",
 				},
@@ -776,7 +878,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "positive",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "positive",
 				},
@@ -789,7 +891,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "positive offset",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "positive",
 				},
@@ -805,7 +907,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "positive bucket_counts",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "positive",
 				},
@@ -821,7 +923,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "negative",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "negative",
 				},
@@ -834,7 +936,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "negative offset",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "negative",
 				},
@@ -850,7 +952,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "negative bucket_counts",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "negative",
 				},
@@ -866,7 +968,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "exemplars",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "exemplars",
 				},
@@ -879,7 +981,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "attributes",
 				},
@@ -893,10 +995,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -907,10 +1013,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -921,10 +1031,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -935,10 +1049,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: 1.2,
@@ -949,10 +1067,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -963,10 +1085,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -980,10 +1106,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -997,10 +1127,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1014,10 +1148,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1031,10 +1169,14 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1054,7 +1196,7 @@ func Test_newPathGetSetter_ExpoHistogramDataPoint(t *testing.T) {
 
 			numberDataPoint := createExpoHistogramDataPointTelemetry()
 
-			ctx := metricTransformContext{
+			ctx := TransformContext{
 				dataPoint: numberDataPoint,
 				metric:    pmetric.NewMetric(),
 				il:        pcommon.NewInstrumentationScope(),
@@ -1107,14 +1249,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		path     []common.Field
+		path     []tql.Field
 		orig     interface{}
 		new      interface{}
 		modified func(pmetric.SummaryDataPoint)
 	}{
 		{
 			name: "start_time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "start_time_unix_nano",
 				},
@@ -1127,7 +1269,7 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "time_unix_nano",
 				},
@@ -1140,7 +1282,7 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "flags",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "flags",
 				},
@@ -1153,7 +1295,7 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "count",
 				},
@@ -1166,7 +1308,7 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "sum",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "sum",
 				},
@@ -1179,7 +1321,7 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "quantile_values",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "quantile_values",
 				},
@@ -1192,7 +1334,7 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "attributes",
 				},
@@ -1206,10 +1348,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -1220,10 +1366,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -1234,10 +1384,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -1248,10 +1402,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: 1.2,
@@ -1262,10 +1420,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -1276,10 +1438,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1293,10 +1459,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1310,10 +1480,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1327,10 +1501,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1344,10 +1522,14 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 		},
 		{
 			name: "attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -1367,7 +1549,7 @@ func Test_newPathGetSetter_SummaryDataPoint(t *testing.T) {
 
 			numberDataPoint := createSummaryDataPointTelemetry()
 
-			ctx := metricTransformContext{
+			ctx := TransformContext{
 				dataPoint: numberDataPoint,
 				metric:    pmetric.NewMetric(),
 				il:        pcommon.NewInstrumentationScope(),
@@ -1442,14 +1624,14 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		path     []common.Field
+		path     []tql.Field
 		orig     interface{}
 		new      interface{}
 		modified func(metric pmetric.Metric)
 	}{
 		{
 			name: "metric",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "metric",
 				},
@@ -1462,7 +1644,7 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 		},
 		{
 			name: "metric name",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "metric",
 				},
@@ -1478,7 +1660,7 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 		},
 		{
 			name: "metric description",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "metric",
 				},
@@ -1494,7 +1676,7 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 		},
 		{
 			name: "metric unit",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "metric",
 				},
@@ -1510,7 +1692,7 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 		},
 		{
 			name: "metric type",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "metric",
 				},
@@ -1525,7 +1707,7 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 		},
 		{
 			name: "metric aggregation_temporality",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "metric",
 				},
@@ -1541,7 +1723,7 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 		},
 		{
 			name: "metric is_monotonic",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "metric",
 				},
@@ -1563,7 +1745,7 @@ func Test_newPathGetSetter_Metric(t *testing.T) {
 
 			metric := createMetricTelemetry()
 
-			ctx := metricTransformContext{
+			ctx := TransformContext{
 				dataPoint: pmetric.NewNumberDataPoint(),
 				metric:    metric,
 				il:        pcommon.NewInstrumentationScope(),
@@ -0,0 +1,120 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlresource"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/internal/tqlcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// TransformContext is the context of the queries executed once per resource.
+type TransformContext struct {
+	resource pcommon.Resource
+}
+
+func NewTransformContext(resource pcommon.Resource) TransformContext {
+	return TransformContext{resource: resource}
+}
+
+func (ctx TransformContext) GetItem() interface{} {
+	return ctx.resource
+}
+
+func (ctx TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return pcommon.NewInstrumentationScope()
+}
+
+func (ctx TransformContext) GetResource() pcommon.Resource {
+	return ctx.resource
+}
+
+// pathGetSetter is a getSetter which has been resolved using a path expression provided by a user.
+type pathGetSetter struct {
+	getter tql.ExprFunc
+	setter func(ctx tql.TransformContext, val interface{})
+}
+
+func (path pathGetSetter) Get(ctx tql.TransformContext) interface{} {
+	return path.getter(ctx)
+}
+
+func (path pathGetSetter) Set(ctx tql.TransformContext, val interface{}) {
+	path.setter(ctx, val)
+}
+
+// ParsePath parses the paths of the resource context.
+func ParsePath(val *tql.Path) (tql.GetSetter, error) {
+	if val != nil && len(val.Fields) > 0 {
+		return newPathGetSetter(val.Fields)
+	}
+	return nil, fmt.Errorf("bad path %v", val)
+}
+
+func newPathGetSetter(path []tql.Field) (tql.GetSetter, error) {
+	switch path[0].Name {
+	case "attributes":
+		keys := path[0].Keys
+		if len(keys) == 0 {
+			return accessAttributes(), nil
+		}
+		return accessAttributesKey(keys), nil
+	case "dropped_attributes_count":
+		return accessDroppedAttributesCount(), nil
+	default:
+		return nil, fmt.Errorf("invalid path expression, unrecognized field %v", path[0].Name)
+	}
+}
+
+func accessAttributes() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetResource().Attributes()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if attrs, ok := val.(pcommon.Map); ok {
+				ctx.GetResource().Attributes().Clear()
+				attrs.CopyTo(ctx.GetResource().Attributes())
+			}
+		},
+	}
+}
+
+func accessAttributesKey(keys []tql.Key) pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetResource().Attributes(), keys)
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetResource().Attributes(), keys, val)
+		},
+	}
+}
+
+func accessDroppedAttributesCount() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetResource().DroppedAttributesCount())
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetResource().SetDroppedAttributesCount(uint32(i))
+			}
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlscope // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlscope"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// TransformContext is the context of the queries executed once per instrumentation scope.
+type TransformContext struct {
+	il       pcommon.InstrumentationScope
+	resource pcommon.Resource
+}
+
+func NewTransformContext(il pcommon.InstrumentationScope, resource pcommon.Resource) TransformContext {
+	return TransformContext{il: il, resource: resource}
+}
+
+func (ctx TransformContext) GetItem() interface{} {
+	return ctx.il
+}
+
+func (ctx TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return ctx.il
+}
+
+func (ctx TransformContext) GetResource() pcommon.Resource {
+	return ctx.resource
+}
+
+// pathGetSetter is a getSetter which has been resolved using a path expression provided by a user.
+type pathGetSetter struct {
+	getter tql.ExprFunc
+	setter func(ctx tql.TransformContext, val interface{})
+}
+
+func (path pathGetSetter) Get(ctx tql.TransformContext) interface{} {
+	return path.getter(ctx)
+}
+
+func (path pathGetSetter) Set(ctx tql.TransformContext, val interface{}) {
+	path.setter(ctx, val)
+}
+
+// ParsePath parses the paths of the scope context. The attributes of the resource the scope belongs to are
+// accessed with the resource prefix, e.g. resource.attributes["service.name"].
+func ParsePath(val *tql.Path) (tql.GetSetter, error) {
+	if val != nil && len(val.Fields) > 0 {
+		return newPathGetSetter(val.Fields)
+	}
+	return nil, fmt.Errorf("bad path %v", val)
+}
+
+func newPathGetSetter(path []tql.Field) (tql.GetSetter, error) {
+	switch path[0].Name {
+	case "resource":
+		if len(path) == 1 {
+			return nil, fmt.Errorf("invalid path expression, a field of resource must be specified")
+		}
+		return tqlresource.ParsePath(&tql.Path{Fields: path[1:]})
+	case "name":
+		return accessName(), nil
+	case "version":
+		return accessVersion(), nil
+	default:
+		return nil, fmt.Errorf("invalid path expression, unrecognized field %v", path[0].Name)
+	}
+}
+
+func accessName() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetInstrumentationScope().Name()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				ctx.GetInstrumentationScope().SetName(str)
+			}
+		},
+	}
+}
+
+func accessVersion() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetInstrumentationScope().Version()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				ctx.GetInstrumentationScope().SetVersion(str)
+			}
+		},
+	}
+}
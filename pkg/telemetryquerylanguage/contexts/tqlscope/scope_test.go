@@ -0,0 +1,145 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlscope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_newPathGetSetter(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     []tql.Field
+		orig     interface{}
+		new      interface{}
+		modified func(il pcommon.InstrumentationScope, resource pcommon.Resource)
+	}{
+		{
+			name: "name",
+			path: []tql.Field{
+				{
+					Name: "name",
+				},
+			},
+			orig: "library",
+			new:  "park",
+			modified: func(il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				il.SetName("park")
+			},
+		},
+		{
+			name: "version",
+			path: []tql.Field{
+				{
+					Name: "version",
+				},
+			},
+			orig: "version",
+			new:  "next",
+			modified: func(il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				il.SetVersion("next")
+			},
+		},
+		{
+			name: "resource attributes string",
+			path: []tql.Field{
+				{
+					Name: "resource",
+				},
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
+				},
+			},
+			orig: "val",
+			new:  "newVal",
+			modified: func(il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				resource.Attributes().UpdateString("str", "newVal")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := newPathGetSetter(tt.path)
+			assert.NoError(t, err)
+
+			il, resource := createScope(), createResource()
+
+			got := accessor.Get(NewTransformContext(il, resource))
+			assert.Equal(t, tt.orig, got)
+
+			accessor.Set(NewTransformContext(il, resource), tt.new)
+
+			exIl, exRes := createScope(), createResource()
+			tt.modified(exIl, exRes)
+
+			assert.Equal(t, exIl, il)
+			assert.Equal(t, exRes, resource)
+		})
+	}
+}
+
+func Test_newPathGetSetter_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		path []tql.Field
+	}{
+		{
+			name: "unknown field",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+				},
+			},
+		},
+		{
+			name: "resource without field",
+			path: []tql.Field{
+				{
+					Name: "resource",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newPathGetSetter(tt.path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func createResource() pcommon.Resource {
+	resource := pcommon.NewResource()
+	resource.Attributes().UpsertString("str", "val")
+	return resource
+}
+
+func createScope() pcommon.InstrumentationScope {
+	il := pcommon.NewInstrumentationScope()
+	il.SetName("library")
+	il.SetVersion("version")
+	return il
+}
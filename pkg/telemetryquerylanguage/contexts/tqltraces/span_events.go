@@ -0,0 +1,156 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:gocritic
+package tqltraces // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/internal/tqlcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// SpanEventTransformContext is the context of the queries executed once per span event.
+type SpanEventTransformContext struct {
+	spanEvent ptrace.SpanEvent
+	span      ptrace.Span
+	il        pcommon.InstrumentationScope
+	resource  pcommon.Resource
+}
+
+func NewSpanEventTransformContext(spanEvent ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) SpanEventTransformContext {
+	return SpanEventTransformContext{
+		spanEvent: spanEvent,
+		span:      span,
+		il:        il,
+		resource:  resource,
+	}
+}
+
+func (ctx SpanEventTransformContext) GetItem() interface{} {
+	return ctx.spanEvent
+}
+
+func (ctx SpanEventTransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return ctx.il
+}
+
+func (ctx SpanEventTransformContext) GetResource() pcommon.Resource {
+	return ctx.resource
+}
+
+func (ctx SpanEventTransformContext) GetSpan() ptrace.Span {
+	return ctx.span
+}
+
+// ParseSpanEventPath parses the paths of the span_event context. The fields of the parent span are accessed
+// with the span prefix, e.g. span.name.
+func ParseSpanEventPath(val *tql.Path) (tql.GetSetter, error) {
+	if val != nil && len(val.Fields) > 0 {
+		return newSpanEventPathGetSetter(val.Fields)
+	}
+	return nil, fmt.Errorf("bad path %v", val)
+}
+
+func newSpanEventPathGetSetter(path []tql.Field) (tql.GetSetter, error) {
+	switch path[0].Name {
+	case "resource", "instrumentation_library":
+		return newPathGetSetter(path)
+	case "span":
+		return accessParentSpan(path[1:])
+	case "name":
+		return accessSpanEventName(), nil
+	case "time_unix_nano":
+		return accessSpanEventTimeUnixNano(), nil
+	case "attributes":
+		keys := path[0].Keys
+		if len(keys) == 0 {
+			return accessSpanEventAttributes(), nil
+		}
+		return accessSpanEventAttributesKey(keys), nil
+	case "dropped_attributes_count":
+		return accessSpanEventDroppedAttributesCount(), nil
+	default:
+		return nil, fmt.Errorf("invalid path expression, unrecognized field %v", path[0].Name)
+	}
+}
+
+func accessSpanEventName() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanEvent).Name()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				ctx.GetItem().(ptrace.SpanEvent).SetName(str)
+			}
+		},
+	}
+}
+
+func accessSpanEventTimeUnixNano() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanEvent).Timestamp().AsTime().UnixNano()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(ptrace.SpanEvent).SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
+			}
+		},
+	}
+}
+
+func accessSpanEventAttributes() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanEvent).Attributes()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if attrs, ok := val.(pcommon.Map); ok {
+				ctx.GetItem().(ptrace.SpanEvent).Attributes().Clear()
+				attrs.CopyTo(ctx.GetItem().(ptrace.SpanEvent).Attributes())
+			}
+		},
+	}
+}
+
+func accessSpanEventAttributesKey(keys []tql.Key) pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetItem().(ptrace.SpanEvent).Attributes(), keys)
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetItem().(ptrace.SpanEvent).Attributes(), keys, val)
+		},
+	}
+}
+
+func accessSpanEventDroppedAttributesCount() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetItem().(ptrace.SpanEvent).DroppedAttributesCount())
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(ptrace.SpanEvent).SetDroppedAttributesCount(uint32(i))
+			}
+		},
+	}
+}
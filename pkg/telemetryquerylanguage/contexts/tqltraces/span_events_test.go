@@ -0,0 +1,252 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqltraces
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_newSpanEventPathGetSetter(t *testing.T) {
+	refEvent, refSpan, _, _ := createSpanEventTelemetry()
+
+	newAttrs := pcommon.NewMap()
+	newAttrs.UpsertString("hello", "world")
+
+	tests := []struct {
+		name     string
+		path     []tql.Field
+		orig     interface{}
+		new      interface{}
+		modified func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource)
+	}{
+		{
+			name: "name",
+			path: []tql.Field{
+				{
+					Name: "name",
+				},
+			},
+			orig: "exception",
+			new:  "log",
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				event.SetName("log")
+			},
+		},
+		{
+			name: "time_unix_nano",
+			path: []tql.Field{
+				{
+					Name: "time_unix_nano",
+				},
+			},
+			orig: int64(100_000_000),
+			new:  int64(200_000_000),
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				event.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(200)))
+			},
+		},
+		{
+			name: "attributes",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+				},
+			},
+			orig: refEvent.Attributes(),
+			new:  newAttrs,
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				event.Attributes().Clear()
+				newAttrs.CopyTo(event.Attributes())
+			},
+		},
+		{
+			name: "attributes string",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("exception.stacktrace"),
+						},
+					},
+				},
+			},
+			orig: "at bear",
+			new:  "redacted",
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				event.Attributes().UpdateString("exception.stacktrace", "redacted")
+			},
+		},
+		{
+			name: "dropped_attributes_count",
+			path: []tql.Field{
+				{
+					Name: "dropped_attributes_count",
+				},
+			},
+			orig: int64(10),
+			new:  int64(20),
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				event.SetDroppedAttributesCount(20)
+			},
+		},
+		{
+			name: "span name",
+			path: []tql.Field{
+				{
+					Name: "span",
+				},
+				{
+					Name: "name",
+				},
+			},
+			orig: refSpan.Name(),
+			new:  "cat",
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				span.SetName("cat")
+			},
+		},
+		{
+			name: "span attributes string",
+			path: []tql.Field{
+				{
+					Name: "span",
+				},
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
+				},
+			},
+			orig: "val",
+			new:  "newVal",
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				span.Attributes().UpdateString("str", "newVal")
+			},
+		},
+		{
+			name: "resource attributes string",
+			path: []tql.Field{
+				{
+					Name: "resource",
+				},
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
+				},
+			},
+			orig: "val",
+			new:  "newVal",
+			modified: func(event ptrace.SpanEvent, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				resource.Attributes().UpdateString("str", "newVal")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := newSpanEventPathGetSetter(tt.path)
+			assert.NoError(t, err)
+
+			event, span, il, resource := createSpanEventTelemetry()
+
+			ctx := SpanEventTransformContext{
+				spanEvent: event,
+				span:      span,
+				il:        il,
+				resource:  resource,
+			}
+			got := accessor.Get(ctx)
+			assert.Equal(t, tt.orig, got)
+
+			accessor.Set(ctx, tt.new)
+
+			exEvent, exSpan, exIl, exRes := createSpanEventTelemetry()
+			tt.modified(exEvent, exSpan, exIl, exRes)
+
+			assert.Equal(t, exEvent, event)
+			assert.Equal(t, exSpan, span)
+			assert.Equal(t, exIl, il)
+			assert.Equal(t, exRes, resource)
+		})
+	}
+}
+
+func Test_newSpanEventPathGetSetter_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		path []tql.Field
+	}{
+		{
+			name: "unknown field",
+			path: []tql.Field{
+				{
+					Name: "kind",
+				},
+			},
+		},
+		{
+			name: "span without field",
+			path: []tql.Field{
+				{
+					Name: "span",
+				},
+			},
+		},
+		{
+			name: "unknown span field",
+			path: []tql.Field{
+				{
+					Name: "span",
+				},
+				{
+					Name: "unknown",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newSpanEventPathGetSetter(tt.path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func createSpanEventTelemetry() (ptrace.SpanEvent, ptrace.Span, pcommon.InstrumentationScope, pcommon.Resource) {
+	span, il, resource := createTelemetry()
+
+	event := ptrace.NewSpanEvent()
+	event.SetName("exception")
+	event.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(100)))
+	event.Attributes().UpsertString("exception.stacktrace", "at bear")
+	event.SetDroppedAttributesCount(10)
+
+	return event, span, il, resource
+}
@@ -0,0 +1,241 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:gocritic
+package tqltraces // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/internal/tqlcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// SpanLinkTransformContext is the context of the queries executed once per span link.
+type SpanLinkTransformContext struct {
+	spanLink ptrace.SpanLink
+	span     ptrace.Span
+	il       pcommon.InstrumentationScope
+	resource pcommon.Resource
+}
+
+func NewSpanLinkTransformContext(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) SpanLinkTransformContext {
+	return SpanLinkTransformContext{
+		spanLink: spanLink,
+		span:     span,
+		il:       il,
+		resource: resource,
+	}
+}
+
+func (ctx SpanLinkTransformContext) GetItem() interface{} {
+	return ctx.spanLink
+}
+
+func (ctx SpanLinkTransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return ctx.il
+}
+
+func (ctx SpanLinkTransformContext) GetResource() pcommon.Resource {
+	return ctx.resource
+}
+
+func (ctx SpanLinkTransformContext) GetSpan() ptrace.Span {
+	return ctx.span
+}
+
+// ParseSpanLinkPath parses the paths of the span_link context. The fields of the parent span are accessed
+// with the span prefix, e.g. span.name.
+func ParseSpanLinkPath(val *tql.Path) (tql.GetSetter, error) {
+	if val != nil && len(val.Fields) > 0 {
+		return newSpanLinkPathGetSetter(val.Fields)
+	}
+	return nil, fmt.Errorf("bad path %v", val)
+}
+
+func newSpanLinkPathGetSetter(path []tql.Field) (tql.GetSetter, error) {
+	switch path[0].Name {
+	case "resource", "instrumentation_library":
+		return newPathGetSetter(path)
+	case "span":
+		return accessParentSpan(path[1:])
+	case "trace_id":
+		if len(path) == 1 {
+			return accessSpanLinkTraceID(), nil
+		}
+		switch path[1].Name {
+		case "string":
+			return accessSpanLinkStringTraceID(), nil
+		}
+	case "span_id":
+		if len(path) == 1 {
+			return accessSpanLinkSpanID(), nil
+		}
+		switch path[1].Name {
+		case "string":
+			return accessSpanLinkStringSpanID(), nil
+		}
+	case "trace_state":
+		keys := path[0].Keys
+		if len(keys) == 0 {
+			return accessSpanLinkTraceState(), nil
+		}
+		if len(keys) == 1 && keys[0].String != nil {
+			return accessSpanLinkTraceStateKey(keys[0].String), nil
+		}
+	case "attributes":
+		keys := path[0].Keys
+		if len(keys) == 0 {
+			return accessSpanLinkAttributes(), nil
+		}
+		return accessSpanLinkAttributesKey(keys), nil
+	case "dropped_attributes_count":
+		return accessSpanLinkDroppedAttributesCount(), nil
+	default:
+		return nil, fmt.Errorf("invalid path expression, unrecognized field %v", path[0].Name)
+	}
+
+	return nil, fmt.Errorf("invalid path expression %v", path)
+}
+
+func accessSpanLinkTraceID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanLink).TraceID()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if newTraceID, ok := val.(pcommon.TraceID); ok {
+				ctx.GetItem().(ptrace.SpanLink).SetTraceID(newTraceID)
+			}
+		},
+	}
+}
+
+func accessSpanLinkStringTraceID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanLink).TraceID().HexString()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				if traceID, err := tqlcommon.ParseTraceID(str); err == nil {
+					ctx.GetItem().(ptrace.SpanLink).SetTraceID(traceID)
+				}
+			}
+		},
+	}
+}
+
+func accessSpanLinkSpanID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanLink).SpanID()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if newSpanID, ok := val.(pcommon.SpanID); ok {
+				ctx.GetItem().(ptrace.SpanLink).SetSpanID(newSpanID)
+			}
+		},
+	}
+}
+
+func accessSpanLinkStringSpanID() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanLink).SpanID().HexString()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				if spanID, err := tqlcommon.ParseSpanID(str); err == nil {
+					ctx.GetItem().(ptrace.SpanLink).SetSpanID(spanID)
+				}
+			}
+		},
+	}
+}
+
+func accessSpanLinkTraceState() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return string(ctx.GetItem().(ptrace.SpanLink).TraceState())
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				ctx.GetItem().(ptrace.SpanLink).SetTraceState(ptrace.TraceState(str))
+			}
+		},
+	}
+}
+
+func accessSpanLinkTraceStateKey(mapKey *string) pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			if ts, err := trace.ParseTraceState(string(ctx.GetItem().(ptrace.SpanLink).TraceState())); err == nil {
+				return ts.Get(*mapKey)
+			}
+			return nil
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if str, ok := val.(string); ok {
+				if ts, err := trace.ParseTraceState(string(ctx.GetItem().(ptrace.SpanLink).TraceState())); err == nil {
+					if updated, err := ts.Insert(*mapKey, str); err == nil {
+						ctx.GetItem().(ptrace.SpanLink).SetTraceState(ptrace.TraceState(updated.String()))
+					}
+				}
+			}
+		},
+	}
+}
+
+func accessSpanLinkAttributes() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem().(ptrace.SpanLink).Attributes()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if attrs, ok := val.(pcommon.Map); ok {
+				ctx.GetItem().(ptrace.SpanLink).Attributes().Clear()
+				attrs.CopyTo(ctx.GetItem().(ptrace.SpanLink).Attributes())
+			}
+		},
+	}
+}
+
+func accessSpanLinkAttributesKey(keys []tql.Key) pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetItem().(ptrace.SpanLink).Attributes(), keys)
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetItem().(ptrace.SpanLink).Attributes(), keys, val)
+		},
+	}
+}
+
+func accessSpanLinkDroppedAttributesCount() pathGetSetter {
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetItem().(ptrace.SpanLink).DroppedAttributesCount())
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if i, ok := val.(int64); ok {
+				ctx.GetItem().(ptrace.SpanLink).SetDroppedAttributesCount(uint32(i))
+			}
+		},
+	}
+}
@@ -13,7 +13,7 @@
 // limitations under the License.
 
 // nolint:gocritic
-package traces // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/traces"
+package tqltraces // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
 
 import (
 	"fmt"
@@ -23,49 +23,59 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/otel/trace"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/internal/tqlcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
-type spanTransformContext struct {
+// TransformContext is the context of the queries executed once per span.
+type TransformContext struct {
 	span     ptrace.Span
 	il       pcommon.InstrumentationScope
 	resource pcommon.Resource
 }
 
-func (ctx spanTransformContext) GetItem() interface{} {
+func NewTransformContext(span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) TransformContext {
+	return TransformContext{
+		span:     span,
+		il:       il,
+		resource: resource,
+	}
+}
+
+func (ctx TransformContext) GetItem() interface{} {
 	return ctx.span
 }
 
-func (ctx spanTransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+func (ctx TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
 	return ctx.il
 }
 
-func (ctx spanTransformContext) GetResource() pcommon.Resource {
+func (ctx TransformContext) GetResource() pcommon.Resource {
 	return ctx.resource
 }
 
 // pathGetSetter is a getSetter which has been resolved using a path expression provided by a user.
 type pathGetSetter struct {
-	getter common.ExprFunc
-	setter func(ctx common.TransformContext, val interface{})
+	getter tql.ExprFunc
+	setter func(ctx tql.TransformContext, val interface{})
 }
 
-func (path pathGetSetter) Get(ctx common.TransformContext) interface{} {
+func (path pathGetSetter) Get(ctx tql.TransformContext) interface{} {
 	return path.getter(ctx)
 }
 
-func (path pathGetSetter) Set(ctx common.TransformContext, val interface{}) {
+func (path pathGetSetter) Set(ctx tql.TransformContext, val interface{}) {
 	path.setter(ctx, val)
 }
 
-func ParsePath(val *common.Path) (common.GetSetter, error) {
+func ParsePath(val *tql.Path) (tql.GetSetter, error) {
 	if val != nil && len(val.Fields) > 0 {
 		return newPathGetSetter(val.Fields)
 	}
 	return nil, fmt.Errorf("bad path %v", val)
 }
 
-func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
+func newPathGetSetter(path []tql.Field) (tql.GetSetter, error) {
 	switch path[0].Name {
 	case "resource":
 		if len(path) == 1 {
@@ -73,11 +83,11 @@ func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
 		}
 		switch path[1].Name {
 		case "attributes":
-			mapKey := path[1].MapKey
-			if mapKey == nil {
+			keys := path[1].Keys
+			if len(keys) == 0 {
 				return accessResourceAttributes(), nil
 			}
-			return accessResourceAttributesKey(mapKey), nil
+			return accessResourceAttributesKey(keys), nil
 		}
 	case "instrumentation_library":
 		if len(path) == 1 {
@@ -106,11 +116,13 @@ func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
 			return accessStringSpanID(), nil
 		}
 	case "trace_state":
-		mapKey := path[0].MapKey
-		if mapKey == nil {
+		keys := path[0].Keys
+		if len(keys) == 0 {
 			return accessTraceState(), nil
 		}
-		return accessTraceStateKey(mapKey), nil
+		if len(keys) == 1 && keys[0].String != nil {
+			return accessTraceStateKey(keys[0].String), nil
+		}
 	case "parent_span_id":
 		return accessParentSpanID(), nil
 	case "name":
@@ -122,11 +134,11 @@ func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
 	case "end_time_unix_nano":
 		return accessEndTimeUnixNano(), nil
 	case "attributes":
-		mapKey := path[0].MapKey
-		if mapKey == nil {
+		keys := path[0].Keys
+		if len(keys) == 0 {
 			return accessAttributes(), nil
 		}
-		return accessAttributesKey(mapKey), nil
+		return accessAttributesKey(keys), nil
 	case "dropped_attributes_count":
 		return accessDroppedAttributesCount(), nil
 	case "events":
@@ -156,10 +168,10 @@ func newPathGetSetter(path []common.Field) (common.GetSetter, error) {
 
 func accessResource() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetResource()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newRes, ok := val.(pcommon.Resource); ok {
 				ctx.GetResource().Attributes().Clear()
 				newRes.CopyTo(ctx.GetResource())
@@ -170,10 +182,10 @@ func accessResource() pathGetSetter {
 
 func accessResourceAttributes() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetResource().Attributes()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if attrs, ok := val.(pcommon.Map); ok {
 				ctx.GetResource().Attributes().Clear()
 				attrs.CopyTo(ctx.GetResource().Attributes())
@@ -182,23 +194,23 @@ func accessResourceAttributes() pathGetSetter {
 	}
 }
 
-func accessResourceAttributesKey(mapKey *string) pathGetSetter {
+func accessResourceAttributesKey(keys []tql.Key) pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return getAttr(ctx.GetResource().Attributes(), *mapKey)
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetResource().Attributes(), keys)
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
-			setAttr(ctx.GetResource().Attributes(), *mapKey, val)
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetResource().Attributes(), keys, val)
 		},
 	}
 }
 
 func accessInstrumentationScope() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetInstrumentationScope()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newIl, ok := val.(pcommon.InstrumentationScope); ok {
 				newIl.CopyTo(ctx.GetInstrumentationScope())
 			}
@@ -208,10 +220,10 @@ func accessInstrumentationScope() pathGetSetter {
 
 func accessInstrumentationScopeName() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetInstrumentationScope().Name()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				ctx.GetInstrumentationScope().SetName(str)
 			}
@@ -221,10 +233,10 @@ func accessInstrumentationScopeName() pathGetSetter {
 
 func accessInstrumentationScopeVersion() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetInstrumentationScope().Version()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				ctx.GetInstrumentationScope().SetVersion(str)
 			}
@@ -234,10 +246,10 @@ func accessInstrumentationScopeVersion() pathGetSetter {
 
 func accessTraceID() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).TraceID()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newTraceID, ok := val.(pcommon.TraceID); ok {
 				ctx.GetItem().(ptrace.Span).SetTraceID(newTraceID)
 			}
@@ -247,12 +259,12 @@ func accessTraceID() pathGetSetter {
 
 func accessStringTraceID() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).TraceID().HexString()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
-				if traceID, err := common.ParseTraceID(str); err == nil {
+				if traceID, err := tqlcommon.ParseTraceID(str); err == nil {
 					ctx.GetItem().(ptrace.Span).SetTraceID(traceID)
 				}
 			}
@@ -262,10 +274,10 @@ func accessStringTraceID() pathGetSetter {
 
 func accessSpanID() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).SpanID()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newSpanID, ok := val.(pcommon.SpanID); ok {
 				ctx.GetItem().(ptrace.Span).SetSpanID(newSpanID)
 			}
@@ -275,12 +287,12 @@ func accessSpanID() pathGetSetter {
 
 func accessStringSpanID() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).SpanID().HexString()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
-				if spanID, err := common.ParseSpanID(str); err == nil {
+				if spanID, err := tqlcommon.ParseSpanID(str); err == nil {
 					ctx.GetItem().(ptrace.Span).SetSpanID(spanID)
 				}
 			}
@@ -290,10 +302,10 @@ func accessStringSpanID() pathGetSetter {
 
 func accessTraceState() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return (string)(ctx.GetItem().(ptrace.Span).TraceState())
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				ctx.GetItem().(ptrace.Span).SetTraceState(ptrace.TraceState(str))
 			}
@@ -303,13 +315,13 @@ func accessTraceState() pathGetSetter {
 
 func accessTraceStateKey(mapKey *string) pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			if ts, err := trace.ParseTraceState(string(ctx.GetItem().(ptrace.Span).TraceState())); err == nil {
 				return ts.Get(*mapKey)
 			}
 			return nil
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				if ts, err := trace.ParseTraceState(string(ctx.GetItem().(ptrace.Span).TraceState())); err == nil {
 					if updated, err := ts.Insert(*mapKey, str); err == nil {
@@ -323,10 +335,10 @@ func accessTraceStateKey(mapKey *string) pathGetSetter {
 
 func accessParentSpanID() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).ParentSpanID()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if newParentSpanID, ok := val.(pcommon.SpanID); ok {
 				ctx.GetItem().(ptrace.Span).SetParentSpanID(newParentSpanID)
 			}
@@ -336,10 +348,10 @@ func accessParentSpanID() pathGetSetter {
 
 func accessName() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).Name()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				ctx.GetItem().(ptrace.Span).SetName(str)
 			}
@@ -349,10 +361,10 @@ func accessName() pathGetSetter {
 
 func accessKind() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return ctx.GetItem().(ptrace.Span).Kind()
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetItem().(ptrace.Span).Kind())
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if i, ok := val.(int64); ok {
 				ctx.GetItem().(ptrace.Span).SetKind(ptrace.SpanKind(i))
 			}
@@ -362,10 +374,10 @@ func accessKind() pathGetSetter {
 
 func accessStartTimeUnixNano() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).StartTimestamp().AsTime().UnixNano()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if i, ok := val.(int64); ok {
 				ctx.GetItem().(ptrace.Span).SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
 			}
@@ -375,10 +387,10 @@ func accessStartTimeUnixNano() pathGetSetter {
 
 func accessEndTimeUnixNano() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).EndTimestamp().AsTime().UnixNano()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if i, ok := val.(int64); ok {
 				ctx.GetItem().(ptrace.Span).SetEndTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
 			}
@@ -388,10 +400,10 @@ func accessEndTimeUnixNano() pathGetSetter {
 
 func accessAttributes() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).Attributes()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if attrs, ok := val.(pcommon.Map); ok {
 				ctx.GetItem().(ptrace.Span).Attributes().Clear()
 				attrs.CopyTo(ctx.GetItem().(ptrace.Span).Attributes())
@@ -400,23 +412,23 @@ func accessAttributes() pathGetSetter {
 	}
 }
 
-func accessAttributesKey(mapKey *string) pathGetSetter {
+func accessAttributesKey(keys []tql.Key) pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return getAttr(ctx.GetItem().(ptrace.Span).Attributes(), *mapKey)
+		getter: func(ctx tql.TransformContext) interface{} {
+			return tqlcommon.GetMapValue(ctx.GetItem().(ptrace.Span).Attributes(), keys)
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
-			setAttr(ctx.GetItem().(ptrace.Span).Attributes(), *mapKey, val)
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			tqlcommon.SetMapValue(ctx.GetItem().(ptrace.Span).Attributes(), keys, val)
 		},
 	}
 }
 
 func accessDroppedAttributesCount() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return int64(ctx.GetItem().(ptrace.Span).DroppedAttributesCount())
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if i, ok := val.(int64); ok {
 				ctx.GetItem().(ptrace.Span).SetDroppedAttributesCount(uint32(i))
 			}
@@ -426,10 +438,10 @@ func accessDroppedAttributesCount() pathGetSetter {
 
 func accessEvents() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).Events()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if slc, ok := val.(ptrace.SpanEventSlice); ok {
 				ctx.GetItem().(ptrace.Span).Events().RemoveIf(func(event ptrace.SpanEvent) bool {
 					return true
@@ -442,10 +454,10 @@ func accessEvents() pathGetSetter {
 
 func accessDroppedEventsCount() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return int64(ctx.GetItem().(ptrace.Span).DroppedEventsCount())
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if i, ok := val.(int64); ok {
 				ctx.GetItem().(ptrace.Span).SetDroppedEventsCount(uint32(i))
 			}
@@ -455,10 +467,10 @@ func accessDroppedEventsCount() pathGetSetter {
 
 func accessLinks() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).Links()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if slc, ok := val.(ptrace.SpanLinkSlice); ok {
 				ctx.GetItem().(ptrace.Span).Links().RemoveIf(func(event ptrace.SpanLink) bool {
 					return true
@@ -471,10 +483,10 @@ func accessLinks() pathGetSetter {
 
 func accessDroppedLinksCount() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return int64(ctx.GetItem().(ptrace.Span).DroppedLinksCount())
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if i, ok := val.(int64); ok {
 				ctx.GetItem().(ptrace.Span).SetDroppedLinksCount(uint32(i))
 			}
@@ -484,10 +496,10 @@ func accessDroppedLinksCount() pathGetSetter {
 
 func accessStatus() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).Status()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if status, ok := val.(ptrace.SpanStatus); ok {
 				status.CopyTo(ctx.GetItem().(ptrace.Span).Status())
 			}
@@ -497,10 +509,10 @@ func accessStatus() pathGetSetter {
 
 func accessStatusCode() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
-			return ctx.GetItem().(ptrace.Span).Status().Code()
+		getter: func(ctx tql.TransformContext) interface{} {
+			return int64(ctx.GetItem().(ptrace.Span).Status().Code())
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if i, ok := val.(int64); ok {
 				ctx.GetItem().(ptrace.Span).Status().SetCode(ptrace.StatusCode(i))
 			}
@@ -510,10 +522,10 @@ func accessStatusCode() pathGetSetter {
 
 func accessStatusMessage() pathGetSetter {
 	return pathGetSetter{
-		getter: func(ctx common.TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem().(ptrace.Span).Status().Message()
 		},
-		setter: func(ctx common.TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			if str, ok := val.(string); ok {
 				ctx.GetItem().(ptrace.Span).Status().SetMessage(str)
 			}
@@ -521,73 +533,32 @@ func accessStatusMessage() pathGetSetter {
 	}
 }
 
-func getAttr(attrs pcommon.Map, mapKey string) interface{} {
-	val, ok := attrs.Get(mapKey)
-	if !ok {
-		return nil
-	}
-	switch val.Type() {
-	case pcommon.ValueTypeString:
-		return val.StringVal()
-	case pcommon.ValueTypeBool:
-		return val.BoolVal()
-	case pcommon.ValueTypeInt:
-		return val.IntVal()
-	case pcommon.ValueTypeDouble:
-		return val.DoubleVal()
-	case pcommon.ValueTypeMap:
-		return val.MapVal()
-	case pcommon.ValueTypeSlice:
-		return val.SliceVal()
-	case pcommon.ValueTypeBytes:
-		return val.MBytesVal()
-	}
-	return nil
-}
-
-func setAttr(attrs pcommon.Map, mapKey string, val interface{}) {
-	switch v := val.(type) {
-	case string:
-		attrs.UpsertString(mapKey, v)
-	case bool:
-		attrs.UpsertBool(mapKey, v)
-	case int64:
-		attrs.UpsertInt(mapKey, v)
-	case float64:
-		attrs.UpsertDouble(mapKey, v)
-	case []byte:
-		attrs.UpsertBytes(mapKey, pcommon.NewImmutableByteSlice(v))
-	case []string:
-		arr := pcommon.NewValueSlice()
-		for _, str := range v {
-			arr.SliceVal().AppendEmpty().SetStringVal(str)
-		}
-		attrs.Upsert(mapKey, arr)
-	case []bool:
-		arr := pcommon.NewValueSlice()
-		for _, b := range v {
-			arr.SliceVal().AppendEmpty().SetBoolVal(b)
-		}
-		attrs.Upsert(mapKey, arr)
-	case []int64:
-		arr := pcommon.NewValueSlice()
-		for _, i := range v {
-			arr.SliceVal().AppendEmpty().SetIntVal(i)
-		}
-		attrs.Upsert(mapKey, arr)
-	case []float64:
-		arr := pcommon.NewValueSlice()
-		for _, f := range v {
-			arr.SliceVal().AppendEmpty().SetDoubleVal(f)
-		}
-		attrs.Upsert(mapKey, arr)
-	case [][]byte:
-		arr := pcommon.NewValueSlice()
-		for _, b := range v {
-			arr.SliceVal().AppendEmpty().SetBytesVal(pcommon.NewImmutableByteSlice(b))
-		}
-		attrs.Upsert(mapKey, arr)
-	default:
-		// TODO(anuraaga): Support set of map type.
+// parentSpanContext is implemented by the contexts nested inside a span, such as span events and span links.
+type parentSpanContext interface {
+	tql.TransformContext
+	GetSpan() ptrace.Span
+}
+
+// accessParentSpan gives the nested contexts access to the paths of the span they belong to.
+func accessParentSpan(path []tql.Field) (tql.GetSetter, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("invalid path expression, a field of span must be specified")
+	}
+	spanPath, err := newPathGetSetter(path)
+	if err != nil {
+		return nil, err
 	}
+	return pathGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			if pctx, ok := ctx.(parentSpanContext); ok {
+				return spanPath.Get(NewTransformContext(pctx.GetSpan(), pctx.GetInstrumentationScope(), pctx.GetResource()))
+			}
+			return nil
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			if pctx, ok := ctx.(parentSpanContext); ok {
+				spanPath.Set(NewTransformContext(pctx.GetSpan(), pctx.GetInstrumentationScope(), pctx.GetResource()), val)
+			}
+		},
+	}, nil
 }
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package traces
+package tqltraces
 
 import (
 	"encoding/hex"
@@ -23,8 +23,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 var (
@@ -66,14 +66,14 @@ func Test_newPathGetSetter(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		path     []common.Field
+		path     []tql.Field
 		orig     interface{}
 		new      interface{}
 		modified func(span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource)
 	}{
 		{
 			name: "trace_id",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "trace_id",
 				},
@@ -86,7 +86,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "span_id",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "span_id",
 				},
@@ -99,7 +99,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "trace_id string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "trace_id",
 				},
@@ -115,7 +115,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "span_id string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "span_id",
 				},
@@ -131,7 +131,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "trace_state",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "trace_state",
 				},
@@ -144,10 +144,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "trace_state key",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "trace_state",
-					MapKey: testhelper.Strp("key1"),
+					Name: "trace_state",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("key1"),
+						},
+					},
 				},
 			},
 			orig: "val1",
@@ -158,7 +162,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "parent_span_id",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "parent_span_id",
 				},
@@ -171,7 +175,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "name",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "name",
 				},
@@ -184,12 +188,12 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "kind",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "kind",
 				},
 			},
-			orig: ptrace.SpanKindServer,
+			orig: int64(ptrace.SpanKindServer),
 			new:  int64(3),
 			modified: func(span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
 				span.SetKind(ptrace.SpanKindClient)
@@ -197,7 +201,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "start_time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "start_time_unix_nano",
 				},
@@ -210,7 +214,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "end_time_unix_nano",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "end_time_unix_nano",
 				},
@@ -223,7 +227,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "attributes",
 				},
@@ -237,10 +241,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -251,10 +259,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -265,10 +277,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -279,10 +295,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: float64(1.2),
@@ -293,10 +313,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -307,10 +331,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -324,10 +352,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -341,10 +373,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -358,10 +394,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -375,10 +415,14 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -390,9 +434,31 @@ func Test_newPathGetSetter(t *testing.T) {
 				span.Attributes().Upsert("arr_bytes", newArrBytes)
 			},
 		},
+		{
+			name: "attributes array index",
+			path: []tql.Field{
+				{
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+						{
+							Int: tqltest.Intp(1),
+						},
+					},
+				},
+			},
+			orig: int64(3),
+			new:  int64(4),
+			modified: func(span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
+				val, _ := span.Attributes().Get("arr_int")
+				val.SliceVal().At(1).SetIntVal(4)
+			},
+		},
 		{
 			name: "dropped_attributes_count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "dropped_attributes_count",
 				},
@@ -405,7 +471,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "events",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "events",
 				},
@@ -421,7 +487,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "dropped_events_count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "dropped_events_count",
 				},
@@ -434,7 +500,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "links",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "links",
 				},
@@ -450,7 +516,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "dropped_links_count",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "dropped_links_count",
 				},
@@ -463,7 +529,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "status",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "status",
 				},
@@ -476,7 +542,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "status code",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "status",
 				},
@@ -484,7 +550,7 @@ func Test_newPathGetSetter(t *testing.T) {
 					Name: "code",
 				},
 			},
-			orig: ptrace.StatusCodeOk,
+			orig: int64(ptrace.StatusCodeOk),
 			new:  int64(ptrace.StatusCodeError),
 			modified: func(span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource) {
 				span.Status().SetCode(ptrace.StatusCodeError)
@@ -492,7 +558,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "status message",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "status",
 				},
@@ -508,7 +574,7 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
@@ -525,13 +591,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("str"),
+						},
+					},
 				},
 			},
 			orig: "val",
@@ -542,13 +612,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bool"),
+						},
+					},
 				},
 			},
 			orig: true,
@@ -559,13 +633,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("int"),
+						},
+					},
 				},
 			},
 			orig: int64(10),
@@ -576,13 +654,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("double"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("double"),
+						},
+					},
 				},
 			},
 			orig: float64(1.2),
@@ -593,13 +675,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("bytes"),
+						},
+					},
 				},
 			},
 			orig: []byte{1, 3, 2},
@@ -610,13 +696,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array string",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_str"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_str"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -630,13 +720,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array bool",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bool"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bool"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -650,13 +744,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array int",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_int"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_int"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -670,13 +768,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array float",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_float"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_float"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -690,13 +792,17 @@ func Test_newPathGetSetter(t *testing.T) {
 		},
 		{
 			name: "resource attributes array bytes",
-			path: []common.Field{
+			path: []tql.Field{
 				{
 					Name: "resource",
 				},
 				{
-					Name:   "attributes",
-					MapKey: testhelper.Strp("arr_bytes"),
+					Name: "attributes",
+					Keys: []tql.Key{
+						{
+							String: tqltest.Strp("arr_bytes"),
+						},
+					},
 				},
 			},
 			orig: func() pcommon.Slice {
@@ -716,14 +822,14 @@ func Test_newPathGetSetter(t *testing.T) {
 
 			span, il, resource := createTelemetry()
 
-			got := accessor.Get(spanTransformContext{
+			got := accessor.Get(TransformContext{
 				span:     span,
 				il:       il,
 				resource: resource,
 			})
 			assert.Equal(t, tt.orig, got)
 
-			accessor.Set(spanTransformContext{
+			accessor.Set(TransformContext{
 				span:     span,
 				il:       il,
 				resource: resource,
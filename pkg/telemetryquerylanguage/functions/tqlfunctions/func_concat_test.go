@@ -0,0 +1,77 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_concat(t *testing.T) {
+	tests := []struct {
+		name      string
+		delimiter string
+		vals      []tql.Getter
+		expected  string
+	}{
+		{
+			name:      "strings",
+			delimiter: "-",
+			vals:      []tql.Getter{literalGetter("hello"), literalGetter("world")},
+			expected:  "hello-world",
+		},
+		{
+			name:      "empty delimiter",
+			delimiter: "",
+			vals:      []tql.Getter{literalGetter("hello"), literalGetter("world")},
+			expected:  "helloworld",
+		},
+		{
+			name:      "single value",
+			delimiter: "-",
+			vals:      []tql.Getter{literalGetter("hello")},
+			expected:  "hello",
+		},
+		{
+			name:      "no values",
+			delimiter: "-",
+			vals:      []tql.Getter{},
+			expected:  "",
+		},
+		{
+			name:      "mixed types",
+			delimiter: ":",
+			vals:      []tql.Getter{literalGetter("a"), literalGetter(int64(1)), literalGetter(2.5), literalGetter(true)},
+			expected:  "a:1:2.5:true",
+		},
+		{
+			name:      "nil values are empty",
+			delimiter: ",",
+			vals:      []tql.Getter{literalGetter("a"), literalGetter(nil), literalGetter("b")},
+			expected:  "a,,b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Concat(tt.delimiter, tt.vals)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, exprFunc(tqltest.TestTransformContext{}))
+		})
+	}
+}
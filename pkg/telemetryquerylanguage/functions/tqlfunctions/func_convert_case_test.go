@@ -0,0 +1,100 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_convertCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   tql.Getter
+		toCase   string
+		expected interface{}
+	}{
+		{
+			name:     "lower",
+			target:   literalGetter("SimpleString"),
+			toCase:   "lower",
+			expected: "simplestring",
+		},
+		{
+			name:     "upper",
+			target:   literalGetter("SimpleString"),
+			toCase:   "upper",
+			expected: "SIMPLESTRING",
+		},
+		{
+			name:     "snake from camel",
+			target:   literalGetter("simpleString"),
+			toCase:   "snake",
+			expected: "simple_string",
+		},
+		{
+			name:     "snake with acronym",
+			target:   literalGetter("HTTPRequest.count"),
+			toCase:   "snake",
+			expected: "http_request_count",
+		},
+		{
+			name:     "camel from snake",
+			target:   literalGetter("simple_string"),
+			toCase:   "camel",
+			expected: "SimpleString",
+		},
+		{
+			name:     "camel with acronym",
+			target:   literalGetter("HTTP request-count"),
+			toCase:   "camel",
+			expected: "HttpRequestCount",
+		},
+		{
+			name:     "camel with digits",
+			target:   literalGetter("v1_beta2"),
+			toCase:   "camel",
+			expected: "V1Beta2",
+		},
+		{
+			name:     "empty string",
+			target:   literalGetter(""),
+			toCase:   "snake",
+			expected: "",
+		},
+		{
+			name:     "target not a string",
+			target:   literalGetter(int64(1)),
+			toCase:   "upper",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ConvertCase(tt.target, tt.toCase)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, exprFunc(tqltest.TestTransformContext{}))
+		})
+	}
+}
+
+func Test_convertCase_validation(t *testing.T) {
+	_, err := ConvertCase(literalGetter("anything"), "kebab")
+	assert.Error(t, err)
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package common
+package tqlfunctions
 
 import (
 	"testing"
@@ -20,7 +20,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_deleteKey(t *testing.T) {
@@ -30,14 +31,14 @@ func Test_deleteKey(t *testing.T) {
 	input.InsertBool("test3", true)
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
 	}
 
 	tests := []struct {
 		name   string
-		target Getter
+		target tql.Getter
 		key    string
 		want   func(pcommon.Map)
 	}{
@@ -78,11 +79,11 @@ func Test_deleteKey(t *testing.T) {
 			scenarioMap := pcommon.NewMap()
 			input.CopyTo(scenarioMap)
 
-			ctx := testhelper.TestTransformContext{
+			ctx := tqltest.TestTransformContext{
 				Item: scenarioMap,
 			}
 
-			exprFunc, _ := deleteKey(tt.target, tt.key)
+			exprFunc, _ := DeleteKey(tt.target, tt.key)
 			exprFunc(ctx)
 
 			expected := pcommon.NewMap()
@@ -95,43 +96,43 @@ func Test_deleteKey(t *testing.T) {
 
 func Test_deleteKey_bad_input(t *testing.T) {
 	input := pcommon.NewValueString("not a map")
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: input,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
 	key := "anything"
 
-	exprFunc, _ := deleteKey(target, key)
+	exprFunc, _ := DeleteKey(target, key)
 	exprFunc(ctx)
 
 	assert.Equal(t, pcommon.NewValueString("not a map"), input)
 }
 
 func Test_deleteKey_get_nil(t *testing.T) {
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: nil,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
 	key := "anything"
 
-	exprFunc, _ := deleteKey(target, key)
+	exprFunc, _ := DeleteKey(target, key)
 	exprFunc(ctx)
 }
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package common
+package tqlfunctions
 
 import (
 	"testing"
@@ -20,7 +20,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_deleteMatchingKeys(t *testing.T) {
@@ -30,14 +31,14 @@ func Test_deleteMatchingKeys(t *testing.T) {
 	input.InsertBool("test3", true)
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
 	}
 
 	tests := []struct {
 		name    string
-		target  Getter
+		target  tql.Getter
 		pattern string
 		want    func(pcommon.Map)
 	}{
@@ -76,11 +77,11 @@ func Test_deleteMatchingKeys(t *testing.T) {
 			scenarioMap := pcommon.NewMap()
 			input.CopyTo(scenarioMap)
 
-			ctx := testhelper.TestTransformContext{
+			ctx := tqltest.TestTransformContext{
 				Item: scenarioMap,
 			}
 
-			exprFunc, _ := deleteMatchingKeys(tt.target, tt.pattern)
+			exprFunc, _ := DeleteMatchingKeys(tt.target, tt.pattern)
 			exprFunc(ctx)
 
 			expected := pcommon.NewMap()
@@ -93,17 +94,17 @@ func Test_deleteMatchingKeys(t *testing.T) {
 
 func Test_deleteMatchingKeys_bad_input(t *testing.T) {
 	input := pcommon.NewValueInt(1)
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: input,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
 	}
 
-	exprFunc, err := deleteMatchingKeys(target, "anything")
+	exprFunc, err := DeleteMatchingKeys(target, "anything")
 	assert.Nil(t, err)
 	exprFunc(ctx)
 
@@ -111,30 +112,30 @@ func Test_deleteMatchingKeys_bad_input(t *testing.T) {
 }
 
 func Test_deleteMatchingKeys_get_nil(t *testing.T) {
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: nil,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
 	}
 
-	exprFunc, _ := deleteMatchingKeys(target, "anything")
+	exprFunc, _ := DeleteMatchingKeys(target, "anything")
 	exprFunc(ctx)
 }
 
 func Test_deleteMatchingKeys_invalid_pattern(t *testing.T) {
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			t.Errorf("nothing should be received in this scenario")
 			return nil
 		},
 	}
 
 	invalidRegexPattern := "*"
-	exprFunc, err := deleteMatchingKeys(target, invalidRegexPattern)
+	exprFunc, err := DeleteMatchingKeys(target, invalidRegexPattern)
 	assert.Nil(t, exprFunc)
 	assert.Contains(t, err.Error(), "error parsing regexp:")
 }
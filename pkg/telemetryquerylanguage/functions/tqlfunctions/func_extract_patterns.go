@@ -0,0 +1,61 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlfunctions"
+
+import (
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+func ExtractPatterns(target tql.Getter, pattern string) (tql.ExprFunc, error) {
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to ExtractPatterns is not a valid pattern: %w", err)
+	}
+
+	namedCaptureGroups := 0
+	for _, name := range r.SubexpNames() {
+		if name != "" {
+			namedCaptureGroups++
+		}
+	}
+	if namedCaptureGroups == 0 {
+		return nil, fmt.Errorf("at least 1 named capture group must be supplied in the pattern supplied to ExtractPatterns")
+	}
+
+	return func(ctx tql.TransformContext) interface{} {
+		valStr, ok := target.Get(ctx).(string)
+		if !ok {
+			return nil
+		}
+
+		matches := r.FindStringSubmatch(valStr)
+		if matches == nil {
+			return nil
+		}
+
+		result := pcommon.NewMap()
+		for i, name := range r.SubexpNames() {
+			if name != "" {
+				result.UpsertString(name, matches[i])
+			}
+		}
+		return result
+	}, nil
+}
@@ -0,0 +1,93 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_extractPatterns(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  tql.Getter
+		pattern string
+		want    func(pcommon.Map)
+	}{
+		{
+			name:    "extract patterns",
+			target:  literalGetter(`a=b c=d`),
+			pattern: `^a=(?P<a>\w+)\s+c=(?P<c>\w+)$`,
+			want: func(expected pcommon.Map) {
+				expected.UpsertString("a", "b")
+				expected.UpsertString("c", "d")
+			},
+		},
+		{
+			name:    "unnamed groups are ignored",
+			target:  literalGetter(`GET /health 200`),
+			pattern: `^(?P<method>\w+) (\S+) (?P<status>\d+)$`,
+			want: func(expected pcommon.Map) {
+				expected.UpsertString("method", "GET")
+				expected.UpsertString("status", "200")
+			},
+		},
+		{
+			name:    "optional group not matched",
+			target:  literalGetter(`GET`),
+			pattern: `^(?P<method>\w+)( (?P<path>\S+))?$`,
+			want: func(expected pcommon.Map) {
+				expected.UpsertString("method", "GET")
+				expected.UpsertString("path", "")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ExtractPatterns(tt.target, tt.pattern)
+			assert.NoError(t, err)
+
+			actual, ok := exprFunc(tqltest.TestTransformContext{}).(pcommon.Map)
+			assert.True(t, ok)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+			assert.Equal(t, expected.Sort(), actual.Sort())
+		})
+	}
+}
+
+func Test_extractPatterns_no_match(t *testing.T) {
+	exprFunc, err := ExtractPatterns(literalGetter("a=b"), `^c=(?P<c>\w+)$`)
+	assert.NoError(t, err)
+	assert.Nil(t, exprFunc(tqltest.TestTransformContext{}))
+
+	exprFunc, err = ExtractPatterns(literalGetter(int64(1)), `^c=(?P<c>\w+)$`)
+	assert.NoError(t, err)
+	assert.Nil(t, exprFunc(tqltest.TestTransformContext{}))
+}
+
+func Test_extractPatterns_validation(t *testing.T) {
+	_, err := ExtractPatterns(literalGetter("anything"), `\K`)
+	assert.Error(t, err)
+
+	_, err = ExtractPatterns(literalGetter("anything"), `(\w+)`)
+	assert.Error(t, err)
+}
@@ -12,27 +12,28 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package common
+package tqlfunctions
 
 import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_isMatch(t *testing.T) {
 	tests := []struct {
 		name     string
-		target   Getter
+		target   tql.Getter
 		pattern  string
 		expected bool
 	}{
 		{
 			name: "replace match true",
 			target: &testGetSetter{
-				getter: func(ctx TransformContext) interface{} {
+				getter: func(ctx tql.TransformContext) interface{} {
 					return "hello world"
 				},
 			},
@@ -42,7 +43,7 @@ func Test_isMatch(t *testing.T) {
 		{
 			name: "replace match false",
 			target: &testGetSetter{
-				getter: func(ctx TransformContext) interface{} {
+				getter: func(ctx tql.TransformContext) interface{} {
 					return "goodbye world"
 				},
 			},
@@ -52,7 +53,7 @@ func Test_isMatch(t *testing.T) {
 		{
 			name: "replace match complex",
 			target: &testGetSetter{
-				getter: func(ctx TransformContext) interface{} {
+				getter: func(ctx tql.TransformContext) interface{} {
 					return "-12.001"
 				},
 			},
@@ -62,7 +63,7 @@ func Test_isMatch(t *testing.T) {
 		{
 			name: "target not a string",
 			target: &testGetSetter{
-				getter: func(ctx TransformContext) interface{} {
+				getter: func(ctx tql.TransformContext) interface{} {
 					return 1
 				},
 			},
@@ -72,7 +73,7 @@ func Test_isMatch(t *testing.T) {
 		{
 			name: "target nil",
 			target: &testGetSetter{
-				getter: func(ctx TransformContext) interface{} {
+				getter: func(ctx tql.TransformContext) interface{} {
 					return nil
 				},
 			},
@@ -82,9 +83,9 @@ func Test_isMatch(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := testhelper.TestTransformContext{}
+			ctx := tqltest.TestTransformContext{}
 
-			exprFunc, _ := isMatch(tt.target, tt.pattern)
+			exprFunc, _ := IsMatch(tt.target, tt.pattern)
 			actual := exprFunc(ctx)
 
 			assert.Equal(t, tt.expected, actual)
@@ -94,10 +95,10 @@ func Test_isMatch(t *testing.T) {
 
 func Test_isMatch_validation(t *testing.T) {
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return "anything"
 		},
 	}
-	_, err := isMatch(target, "\\K")
+	_, err := IsMatch(target, "\\K")
 	assert.Error(t, err)
 }
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package common
+package tqlfunctions
 
 import (
 	"testing"
@@ -20,7 +20,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_keepKeys(t *testing.T) {
@@ -30,10 +31,10 @@ func Test_keepKeys(t *testing.T) {
 	input.InsertBool("test3", true)
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			ctx.GetItem().(pcommon.Map).Clear()
 			val.(pcommon.Map).CopyTo(ctx.GetItem().(pcommon.Map))
 		},
@@ -41,7 +42,7 @@ func Test_keepKeys(t *testing.T) {
 
 	tests := []struct {
 		name   string
-		target GetSetter
+		target tql.GetSetter
 		keys   []string
 		want   func(pcommon.Map)
 	}{
@@ -94,11 +95,11 @@ func Test_keepKeys(t *testing.T) {
 			scenarioMap := pcommon.NewMap()
 			input.CopyTo(scenarioMap)
 
-			ctx := testhelper.TestTransformContext{
+			ctx := tqltest.TestTransformContext{
 				Item: scenarioMap,
 			}
 
-			exprFunc, _ := keepKeys(tt.target, tt.keys)
+			exprFunc, _ := KeepKeys(tt.target, tt.keys)
 			exprFunc(ctx)
 
 			expected := pcommon.NewMap()
@@ -111,43 +112,43 @@ func Test_keepKeys(t *testing.T) {
 
 func Test_keepKeys_bad_input(t *testing.T) {
 	input := pcommon.NewValueString("not a map")
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: input,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
 	keys := []string{"anything"}
 
-	exprFunc, _ := keepKeys(target, keys)
+	exprFunc, _ := KeepKeys(target, keys)
 	exprFunc(ctx)
 
 	assert.Equal(t, pcommon.NewValueString("not a map"), input)
 }
 
 func Test_keepKeys_get_nil(t *testing.T) {
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: nil,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
 	keys := []string{"anything"}
 
-	exprFunc, _ := keepKeys(target, keys)
+	exprFunc, _ := KeepKeys(target, keys)
 	exprFunc(ctx)
 }
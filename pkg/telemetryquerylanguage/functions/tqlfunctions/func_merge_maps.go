@@ -0,0 +1,61 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlfunctions"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+const (
+	mergeInsert = "insert"
+	mergeUpdate = "update"
+	mergeUpsert = "upsert"
+)
+
+// MergeMaps merges the map returned by source into the map returned by target. target is modified in place, so
+// its Get must return a reference to the map to update.
+func MergeMaps(target tql.GetSetter, source tql.Getter, strategy string) (tql.ExprFunc, error) {
+	if strategy != mergeInsert && strategy != mergeUpdate && strategy != mergeUpsert {
+		return nil, fmt.Errorf("invalid strategy for merge_maps function, %q must be one of insert, update or upsert", strategy)
+	}
+
+	return func(ctx tql.TransformContext) interface{} {
+		targetMap, ok := target.Get(ctx).(pcommon.Map)
+		if !ok {
+			return nil
+		}
+		sourceMap, ok := source.Get(ctx).(pcommon.Map)
+		if !ok {
+			return nil
+		}
+
+		sourceMap.Range(func(key string, val pcommon.Value) bool {
+			switch strategy {
+			case mergeInsert:
+				targetMap.Insert(key, val)
+			case mergeUpdate:
+				targetMap.Update(key, val)
+			case mergeUpsert:
+				targetMap.Upsert(key, val)
+			}
+			return true
+		})
+		return nil
+	}, nil
+}
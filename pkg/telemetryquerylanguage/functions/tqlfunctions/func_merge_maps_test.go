@@ -0,0 +1,130 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_mergeMaps(t *testing.T) {
+	input := pcommon.NewMap()
+	input.InsertString("attr1", "value1")
+	input.InsertString("attr2", "value2")
+
+	source := pcommon.NewMap()
+	source.InsertString("attr2", "new value2")
+	source.InsertString("attr3", "value3")
+
+	target := &testGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			t.Errorf("the target should be updated in place")
+		},
+	}
+
+	tests := []struct {
+		name     string
+		source   tql.Getter
+		strategy string
+		want     func(pcommon.Map)
+	}{
+		{
+			name:     "insert",
+			source:   literalGetter(source),
+			strategy: "insert",
+			want: func(expected pcommon.Map) {
+				expected.InsertString("attr3", "value3")
+			},
+		},
+		{
+			name:     "update",
+			source:   literalGetter(source),
+			strategy: "update",
+			want: func(expected pcommon.Map) {
+				expected.UpdateString("attr2", "new value2")
+			},
+		},
+		{
+			name:     "upsert",
+			source:   literalGetter(source),
+			strategy: "upsert",
+			want: func(expected pcommon.Map) {
+				expected.UpsertString("attr2", "new value2")
+				expected.UpsertString("attr3", "value3")
+			},
+		},
+		{
+			name:     "source not a map",
+			source:   literalGetter("not a map"),
+			strategy: "upsert",
+			want:     func(expected pcommon.Map) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			input.CopyTo(scenarioMap)
+
+			ctx := tqltest.TestTransformContext{
+				Item: scenarioMap,
+			}
+
+			exprFunc, err := MergeMaps(target, tt.source, tt.strategy)
+			assert.NoError(t, err)
+			assert.Nil(t, exprFunc(ctx))
+
+			expected := pcommon.NewMap()
+			input.CopyTo(expected)
+			tt.want(expected)
+
+			assert.Equal(t, expected.Sort(), scenarioMap.Sort())
+		})
+	}
+}
+
+func Test_mergeMaps_bad_input(t *testing.T) {
+	input := pcommon.NewValueString("not a map")
+	ctx := tqltest.TestTransformContext{
+		Item: input,
+	}
+
+	target := &testGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return ctx.GetItem()
+		},
+		setter: func(ctx tql.TransformContext, val interface{}) {
+			t.Errorf("nothing should be set in this scenario")
+		},
+	}
+
+	exprFunc, err := MergeMaps(target, literalGetter(pcommon.NewMap()), "upsert")
+	assert.NoError(t, err)
+	exprFunc(ctx)
+
+	assert.Equal(t, pcommon.NewValueString("not a map"), input)
+}
+
+func Test_mergeMaps_validation(t *testing.T) {
+	_, err := MergeMaps(&testGetSetter{}, literalGetter(pcommon.NewMap()), "replace")
+	assert.Error(t, err)
+}
@@ -0,0 +1,99 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_parseJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		target tql.Getter
+		want   func(pcommon.Map)
+	}{
+		{
+			name:   "flat object",
+			target: literalGetter(`{"string":"value","int":1,"float":1.5,"bool":true,"null":null}`),
+			want: func(expected pcommon.Map) {
+				expected.UpsertString("string", "value")
+				expected.UpsertInt("int", 1)
+				expected.UpsertDouble("float", 1.5)
+				expected.UpsertBool("bool", true)
+				expected.Upsert("null", pcommon.NewValueEmpty())
+			},
+		},
+		{
+			name:   "nested object and array",
+			target: literalGetter(`{"http":{"status":200},"tags":["a",2]}`),
+			want: func(expected pcommon.Map) {
+				http := pcommon.NewValueMap()
+				http.MapVal().UpsertInt("status", 200)
+				expected.Upsert("http", http)
+
+				tags := pcommon.NewValueSlice()
+				tags.SliceVal().AppendEmpty().SetStringVal("a")
+				tags.SliceVal().AppendEmpty().SetIntVal(2)
+				expected.Upsert("tags", tags)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseJSON(tt.target)
+			assert.NoError(t, err)
+
+			actual, ok := exprFunc(tqltest.TestTransformContext{}).(pcommon.Map)
+			assert.True(t, ok)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+			assert.Equal(t, expected.Sort(), actual.Sort())
+		})
+	}
+}
+
+func Test_parseJSON_invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		target tql.Getter
+	}{
+		{
+			name:   "invalid json",
+			target: literalGetter(`{"key":`),
+		},
+		{
+			name:   "not an object",
+			target: literalGetter(`["a", "b"]`),
+		},
+		{
+			name:   "target not a string",
+			target: literalGetter(int64(1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseJSON(tt.target)
+			assert.NoError(t, err)
+			assert.Nil(t, exprFunc(tqltest.TestTransformContext{}))
+		})
+	}
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package common
+package tqlfunctions
 
 import (
 	"testing"
@@ -20,7 +20,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_replaceAllPatterns(t *testing.T) {
@@ -30,10 +31,10 @@ func Test_replaceAllPatterns(t *testing.T) {
 	input.InsertString("test3", "goodbye world1 and world2")
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			ctx.GetItem().(pcommon.Map).Clear()
 			val.(pcommon.Map).CopyTo(ctx.GetItem().(pcommon.Map))
 		},
@@ -41,7 +42,7 @@ func Test_replaceAllPatterns(t *testing.T) {
 
 	tests := []struct {
 		name        string
-		target      GetSetter
+		target      tql.GetSetter
 		pattern     string
 		replacement string
 		want        func(pcommon.Map)
@@ -88,11 +89,11 @@ func Test_replaceAllPatterns(t *testing.T) {
 			scenarioMap := pcommon.NewMap()
 			input.CopyTo(scenarioMap)
 
-			ctx := testhelper.TestTransformContext{
+			ctx := tqltest.TestTransformContext{
 				Item: scenarioMap,
 			}
 
-			exprFunc, _ := replaceAllPatterns(tt.target, tt.pattern, tt.replacement)
+			exprFunc, _ := ReplaceAllPatterns(tt.target, tt.pattern, tt.replacement)
 			exprFunc(ctx)
 
 			expected := pcommon.NewMap()
@@ -105,20 +106,20 @@ func Test_replaceAllPatterns(t *testing.T) {
 
 func Test_replaceAllPatterns_bad_input(t *testing.T) {
 	input := pcommon.NewValueString("not a map")
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: input,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
-	exprFunc, err := replaceAllPatterns(target, "regexpattern", "{replacement}")
+	exprFunc, err := ReplaceAllPatterns(target, "regexpattern", "{replacement}")
 	assert.Nil(t, err)
 
 	exprFunc(ctx)
@@ -127,37 +128,37 @@ func Test_replaceAllPatterns_bad_input(t *testing.T) {
 }
 
 func Test_replaceAllPatterns_get_nil(t *testing.T) {
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: nil,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
-	exprFunc, err := replaceAllPatterns(target, "regexp", "{anything}")
+	exprFunc, err := ReplaceAllPatterns(target, "regexp", "{anything}")
 	assert.Nil(t, err)
 	exprFunc(ctx)
 }
 
 func Test_replaceAllPatterns_invalid_pattern(t *testing.T) {
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			t.Errorf("nothing should be received in this scenario")
 			return nil
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
 	invalidRegexPattern := "*"
-	exprFunc, err := replaceAllPatterns(target, invalidRegexPattern, "{anything}")
+	exprFunc, err := ReplaceAllPatterns(target, invalidRegexPattern, "{anything}")
 	assert.Nil(t, exprFunc)
 	assert.Contains(t, err.Error(), "error parsing regexp:")
 }
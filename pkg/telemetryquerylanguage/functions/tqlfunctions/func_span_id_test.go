@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package common
+package tqlfunctions
 
 import (
 	"testing"
@@ -20,7 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_spanID(t *testing.T) {
@@ -38,9 +38,9 @@ func Test_spanID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
-			ctx := testhelper.TestTransformContext{}
+			ctx := tqltest.TestTransformContext{}
 
-			exprFunc, _ := spanID(tt.bytes)
+			exprFunc, _ := SpanID(tt.bytes)
 			actual := exprFunc(ctx)
 
 			assert.Equal(t, tt.want, actual)
@@ -64,7 +64,7 @@ func Test_spanID_validation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := traceID(tt.bytes)
+			_, err := TraceID(tt.bytes)
 			assert.Error(t, err, "span ids must be 8 bytes")
 		})
 	}
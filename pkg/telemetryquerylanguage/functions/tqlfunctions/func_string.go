@@ -0,0 +1,64 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlfunctions"
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+func String(target tql.Getter) (tql.ExprFunc, error) {
+	return func(ctx tql.TransformContext) interface{} {
+		if str, ok := toString(target.Get(ctx)); ok {
+			return str
+		}
+		return nil
+	}, nil
+}
+
+// toString converts val into its string representation. Byte slices and IDs are hex encoded, and maps and slices
+// are encoded as JSON. false is returned for nil and unsupported types.
+func toString(val interface{}) (string, bool) {
+	switch v := val.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case []byte:
+		return hex.EncodeToString(v), true
+	case pcommon.TraceID:
+		return v.HexString(), true
+	case pcommon.SpanID:
+		return v.HexString(), true
+	case pcommon.Map:
+		if b, err := json.Marshal(v.AsRaw()); err == nil {
+			return string(b), true
+		}
+	case pcommon.Slice:
+		if b, err := json.Marshal(v.AsRaw()); err == nil {
+			return string(b), true
+		}
+	}
+	return "", false
+}
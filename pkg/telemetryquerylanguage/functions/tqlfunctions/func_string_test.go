@@ -0,0 +1,109 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func Test_string(t *testing.T) {
+	m := pcommon.NewMap()
+	m.UpsertString("key", "val")
+	m.UpsertInt("int", 1)
+
+	s := pcommon.NewSlice()
+	s.AppendEmpty().SetStringVal("a")
+	s.AppendEmpty().SetBoolVal(true)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "string",
+			value:    "hello",
+			expected: "hello",
+		},
+		{
+			name:     "bool",
+			value:    true,
+			expected: "true",
+		},
+		{
+			name:     "int",
+			value:    int64(-12),
+			expected: "-12",
+		},
+		{
+			name:     "float",
+			value:    1.5,
+			expected: "1.5",
+		},
+		{
+			name:     "bytes",
+			value:    []byte{1, 2, 0xab},
+			expected: "0102ab",
+		},
+		{
+			name:     "trace id",
+			value:    pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+			expected: "0102030405060708090a0b0c0d0e0f10",
+		},
+		{
+			name:     "span id",
+			value:    pcommon.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+			expected: "0102030405060708",
+		},
+		{
+			name:     "map",
+			value:    m,
+			expected: `{"int":1,"key":"val"}`,
+		},
+		{
+			name:     "slice",
+			value:    s,
+			expected: `["a",true]`,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+		{
+			name:     "unsupported type",
+			value:    struct{}{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &testGetSetter{
+				getter: func(ctx tql.TransformContext) interface{} {
+					return tt.value
+				},
+			}
+			exprFunc, err := String(target)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, exprFunc(tqltest.TestTransformContext{}))
+		})
+	}
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package common
+package tqlfunctions
 
 import (
 	"testing"
@@ -20,7 +20,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common/testhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
 func Test_truncateAll(t *testing.T) {
@@ -30,10 +31,10 @@ func Test_truncateAll(t *testing.T) {
 	input.InsertBool("test3", true)
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			ctx.GetItem().(pcommon.Map).Clear()
 			val.(pcommon.Map).CopyTo(ctx.GetItem().(pcommon.Map))
 		},
@@ -41,7 +42,7 @@ func Test_truncateAll(t *testing.T) {
 
 	tests := []struct {
 		name   string
-		target GetSetter
+		target tql.GetSetter
 		limit  int64
 		want   func(pcommon.Map)
 	}{
@@ -95,11 +96,11 @@ func Test_truncateAll(t *testing.T) {
 			scenarioMap := pcommon.NewMap()
 			input.CopyTo(scenarioMap)
 
-			ctx := testhelper.TestTransformContext{
+			ctx := tqltest.TestTransformContext{
 				Item: scenarioMap,
 			}
 
-			exprFunc, _ := truncateAll(tt.target, tt.limit)
+			exprFunc, _ := TruncateAll(tt.target, tt.limit)
 			exprFunc(ctx)
 
 			expected := pcommon.NewMap()
@@ -113,7 +114,7 @@ func Test_truncateAll(t *testing.T) {
 func Test_truncateAll_validation(t *testing.T) {
 	tests := []struct {
 		name   string
-		target GetSetter
+		target tql.GetSetter
 		limit  int64
 	}{
 		{
@@ -124,7 +125,7 @@ func Test_truncateAll_validation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := truncateAll(tt.target, tt.limit)
+			_, err := TruncateAll(tt.target, tt.limit)
 			assert.Error(t, err, "invalid limit for truncate_all function, -1 cannot be negative")
 		})
 	}
@@ -132,39 +133,39 @@ func Test_truncateAll_validation(t *testing.T) {
 
 func Test_truncateAll_bad_input(t *testing.T) {
 	input := pcommon.NewValueString("not a map")
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: input,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
-	exprFunc, _ := truncateAll(target, 1)
+	exprFunc, _ := TruncateAll(target, 1)
 	exprFunc(ctx)
 
 	assert.Equal(t, pcommon.NewValueString("not a map"), input)
 }
 
 func Test_truncateAll_get_nil(t *testing.T) {
-	ctx := testhelper.TestTransformContext{
+	ctx := tqltest.TestTransformContext{
 		Item: nil,
 	}
 
 	target := &testGetSetter{
-		getter: func(ctx TransformContext) interface{} {
+		getter: func(ctx tql.TransformContext) interface{} {
 			return ctx.GetItem()
 		},
-		setter: func(ctx TransformContext, val interface{}) {
+		setter: func(ctx tql.TransformContext, val interface{}) {
 			t.Errorf("nothing should be set in this scenario")
 		},
 	}
 
-	exprFunc, _ := truncateAll(target, 1)
+	exprFunc, _ := TruncateAll(target, 1)
 	exprFunc(ctx)
 }
@@ -0,0 +1,34 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlfunctions"
+
+// Converters returns the functions returning values, which can be used in conditions as well as in the arguments
+// of other functions. A new map is returned on each call, so that components can add their own functions to it.
+func Converters() map[string]interface{} {
+	return map[string]interface{}{
+		"TraceID":         TraceID,
+		"SpanID":          SpanID,
+		"IsMatch":         IsMatch,
+		"Concat":          Concat,
+		"Split":           Split,
+		"Substring":       Substring,
+		"ConvertCase":     ConvertCase,
+		"Int":             Int,
+		"Double":          Double,
+		"String":          String,
+		"ParseJSON":       ParseJSON,
+		"ExtractPatterns": ExtractPatterns,
+	}
+}
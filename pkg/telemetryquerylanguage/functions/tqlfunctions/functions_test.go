@@ -0,0 +1,42 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tqlfunctions
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// testGetSetter is a getSetter which has been resolved using a path expression provided by a user.
+type testGetSetter struct {
+	getter tql.ExprFunc
+	setter func(ctx tql.TransformContext, val interface{})
+}
+
+func (path testGetSetter) Get(ctx tql.TransformContext) interface{} {
+	return path.getter(ctx)
+}
+
+func (path testGetSetter) Set(ctx tql.TransformContext, val interface{}) {
+	path.setter(ctx, val)
+}
+
+// literalGetter is a getter which always returns val.
+func literalGetter(val interface{}) tql.Getter {
+	return &testGetSetter{
+		getter: func(ctx tql.TransformContext) interface{} {
+			return val
+		},
+	}
+}
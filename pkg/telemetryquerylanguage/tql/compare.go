@@ -0,0 +1,141 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tql // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// compare compares a with b using op. Numbers are compared by value, whether they are ints or floats, while
+// values of different types are never equal and can't be ordered. Only numbers, strings, byte slices, times
+// and durations can be ordered; ordering comparisons on other values are always false.
+func compare(a interface{}, b interface{}, op CompareOp) bool {
+	// nil is only equal to nil.
+	if a == nil || b == nil {
+		return compareEquality(a == nil && b == nil, op)
+	}
+
+	switch av := a.(type) {
+	case int64:
+		switch bv := b.(type) {
+		case int64:
+			return compareResult(compareInt64s(av, bv), op)
+		case float64:
+			return compareFloat64s(float64(av), bv, op)
+		}
+	case float64:
+		switch bv := b.(type) {
+		case int64:
+			return compareFloat64s(av, float64(bv), op)
+		case float64:
+			return compareFloat64s(av, bv, op)
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			return compareResult(strings.Compare(av, bv), op)
+		}
+	case []byte:
+		if bv, ok := b.([]byte); ok {
+			return compareResult(bytes.Compare(av, bv), op)
+		}
+	case time.Time:
+		if bv, ok := b.(time.Time); ok {
+			return compareResult(compareTimes(av, bv), op)
+		}
+	case time.Duration:
+		if bv, ok := b.(time.Duration); ok {
+			return compareResult(compareInt64s(int64(av), int64(bv)), op)
+		}
+	default:
+		// Other values can only be equal when they have the same comparable type, such as bools and IDs.
+		if reflect.TypeOf(a) == reflect.TypeOf(b) && reflect.TypeOf(a).Comparable() {
+			return compareEquality(a == b, op)
+		}
+	}
+	return compareEquality(false, op)
+}
+
+// compareEquality evaluates an operator for values that can only be checked for equality.
+func compareEquality(equal bool, op CompareOp) bool {
+	switch op {
+	case EQ:
+		return equal
+	case NE:
+		return !equal
+	default:
+		return false
+	}
+}
+
+// compareResult evaluates an operator from the result of a three-way comparison.
+func compareResult(result int, op CompareOp) bool {
+	switch op {
+	case EQ:
+		return result == 0
+	case NE:
+		return result != 0
+	case LT:
+		return result < 0
+	case LTE:
+		return result <= 0
+	case GTE:
+		return result >= 0
+	case GT:
+		return result > 0
+	default:
+		return false
+	}
+}
+
+func compareInt64s(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareFloat64s is the same as compareResult for floats, except that NaN is not equal to anything.
+func compareFloat64s(a float64, b float64, op CompareOp) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return op == NE
+	}
+	switch {
+	case a < b:
+		return compareResult(-1, op)
+	case a > b:
+		return compareResult(1, op)
+	default:
+		return compareResult(0, op)
+	}
+}
+
+func compareTimes(a time.Time, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	default:
+		return 0
+	}
+}
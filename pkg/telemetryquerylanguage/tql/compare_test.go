@@ -0,0 +1,68 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tql
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_compare(t *testing.T) {
+	now := time.Now()
+	traceID := pcommon.NewTraceID([16]byte{1})
+	// the expected results for EQ, NE, LT, LTE, GTE and GT
+	tests := []struct {
+		name string
+		a    interface{}
+		b    interface{}
+		want []bool
+	}{
+		{"nil and nil", nil, nil, []bool{true, false, false, false, false, false}},
+		{"nil and string", nil, "a", []bool{false, true, false, false, false, false}},
+		{"string and nil", "a", nil, []bool{false, true, false, false, false, false}},
+		{"equal ints", int64(1), int64(1), []bool{true, false, false, true, true, false}},
+		{"lower int", int64(1), int64(2), []bool{false, true, true, true, false, false}},
+		{"greater int", int64(2), int64(1), []bool{false, true, false, false, true, true}},
+		{"int and equal float", int64(1), 1.0, []bool{true, false, false, true, true, false}},
+		{"float and greater int", 1.5, int64(2), []bool{false, true, true, true, false, false}},
+		{"lower float", 1.5, 2.5, []bool{false, true, true, true, false, false}},
+		{"NaN", math.NaN(), math.NaN(), []bool{false, true, false, false, false, false}},
+		{"equal strings", "a", "a", []bool{true, false, false, true, true, false}},
+		{"greater string", "b", "a", []bool{false, true, false, false, true, true}},
+		{"string and int", "1", int64(1), []bool{false, true, false, false, false, false}},
+		{"lower bytes", []byte{1}, []byte{2}, []bool{false, true, true, true, false, false}},
+		{"bytes and string", []byte("a"), "a", []bool{false, true, false, false, false, false}},
+		{"earlier time", now, now.Add(time.Second), []bool{false, true, true, true, false, false}},
+		{"same time", now, now.UTC(), []bool{true, false, false, true, true, false}},
+		{"greater duration", 2 * time.Second, time.Second, []bool{false, true, false, false, true, true}},
+		{"equal bools", true, true, []bool{true, false, false, false, false, false}},
+		{"different bools", true, false, []bool{false, true, false, false, false, false}},
+		{"equal IDs", traceID, pcommon.NewTraceID([16]byte{1}), []bool{true, false, false, false, false, false}},
+		{"uncomparable values", []string{"a"}, []string{"a"}, []bool{false, true, false, false, false, false}},
+	}
+	ops := []CompareOp{EQ, NE, LT, LTE, GTE, GT}
+	for _, tt := range tests {
+		for i, op := range ops {
+			t.Run(fmt.Sprintf("%s %s", tt.name, op), func(t *testing.T) {
+				assert.Equal(t, tt.want[i], compare(tt.a, tt.b, op))
+			})
+		}
+	}
+}
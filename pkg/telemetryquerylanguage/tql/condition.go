@@ -24,34 +24,125 @@ var alwaysTrue = func(ctx TransformContext) bool {
 	return true
 }
 
-func newConditionEvaluator(cond *Condition, functions map[string]interface{}, pathParser PathExpressionParser) (CondFunc, error) {
-	if cond == nil {
-		return alwaysTrue, nil
+var alwaysFalse = func(ctx TransformContext) bool {
+	return false
+}
+
+func newComparisonEvaluator(comparison *Comparison, functions map[string]interface{}, pathParser PathExpressionParser) (CondFunc, error) {
+	if comparison == nil {
+		return nil, fmt.Errorf("comparison cannot be nil")
 	}
-	left, err := NewGetter(cond.Left, functions, pathParser)
+	left, err := NewGetter(comparison.Left, functions, pathParser)
 	if err != nil {
 		return nil, err
 	}
-	right, err := NewGetter(cond.Right, functions, pathParser)
+	right, err := NewGetter(comparison.Right, functions, pathParser)
 	// TODO(anuraaga): Check if both left and right are literals and const-evaluate
 	if err != nil {
 		return nil, err
 	}
 
-	switch cond.Op {
-	case "==":
+	switch comparison.Op {
+	case EQ, NE, LT, LTE, GTE, GT:
+		op := comparison.Op
 		return func(ctx TransformContext) bool {
 			a := left.Get(ctx)
 			b := right.Get(ctx)
-			return a == b
+			return compare(a, b, op)
 		}, nil
-	case "!=":
+	}
+
+	return nil, fmt.Errorf("unrecognized comparison operator %v", comparison.Op)
+}
+
+func newBooleanExpressionEvaluator(expr *BooleanExpression, functions map[string]interface{}, pathParser PathExpressionParser) (CondFunc, error) {
+	if expr == nil {
+		return alwaysTrue, nil
+	}
+	left, err := newTermEvaluator(expr.Left, functions, pathParser)
+	if err != nil {
+		return nil, err
+	}
+	if len(expr.Right) == 0 {
+		return left, nil
+	}
+	terms := []CondFunc{left}
+	for _, term := range expr.Right {
+		eval, err := newTermEvaluator(term, functions, pathParser)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, eval)
+	}
+	return func(ctx TransformContext) bool {
+		for _, term := range terms {
+			if term(ctx) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func newTermEvaluator(term *Term, functions map[string]interface{}, pathParser PathExpressionParser) (CondFunc, error) {
+	if term == nil {
+		return nil, fmt.Errorf("term cannot be nil")
+	}
+	left, err := newBooleanValueEvaluator(term.Left, functions, pathParser)
+	if err != nil {
+		return nil, err
+	}
+	if len(term.Right) == 0 {
+		return left, nil
+	}
+	values := []CondFunc{left}
+	for _, value := range term.Right {
+		eval, err := newBooleanValueEvaluator(value, functions, pathParser)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, eval)
+	}
+	return func(ctx TransformContext) bool {
+		for _, value := range values {
+			if !value(ctx) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func newBooleanValueEvaluator(value *BooleanValue, functions map[string]interface{}, pathParser PathExpressionParser) (CondFunc, error) {
+	if value == nil {
+		return nil, fmt.Errorf("boolean value cannot be nil")
+	}
+
+	var eval CondFunc
+	var err error
+	switch {
+	case value.Comparison != nil:
+		eval, err = newComparisonEvaluator(value.Comparison, functions, pathParser)
+	case value.ConstExpr != nil:
+		if *value.ConstExpr {
+			eval = alwaysTrue
+		} else {
+			eval = alwaysFalse
+		}
+	case value.SubExpr != nil:
+		eval, err = newBooleanExpressionEvaluator(value.SubExpr, functions, pathParser)
+	default:
+		// In practice, can't happen since the DSL grammar guarantees one is set
+		return nil, fmt.Errorf("no boolean value field set. This is a bug in the telemetry query language")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if value.Negation {
 		return func(ctx TransformContext) bool {
-			a := left.Get(ctx)
-			b := right.Get(ctx)
-			return a != b
+			return !eval(ctx)
 		}, nil
 	}
-
-	return nil, fmt.Errorf("unrecognized boolean operation %v", cond.Op)
+	return eval, nil
 }
@@ -18,43 +18,44 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
 )
 
-func Test_newConditionEvaluator(t *testing.T) {
+func Test_newComparisonEvaluator(t *testing.T) {
 	tests := []struct {
-		name string
-		cond *Condition
-		item interface{}
+		name       string
+		comparison *Comparison
+		item       interface{}
 	}{
 		{
 			name: "literals match",
-			cond: &Condition{
+			comparison: &Comparison{
 				Left: Value{
 					String: tqltest.Strp("hello"),
 				},
 				Right: Value{
 					String: tqltest.Strp("hello"),
 				},
-				Op: "==",
+				Op: EQ,
 			},
 		},
 		{
 			name: "literals don't match",
-			cond: &Condition{
+			comparison: &Comparison{
 				Left: Value{
 					String: tqltest.Strp("hello"),
 				},
 				Right: Value{
 					String: tqltest.Strp("goodbye"),
 				},
-				Op: "!=",
+				Op: NE,
 			},
 		},
 		{
 			name: "path expression matches",
-			cond: &Condition{
+			comparison: &Comparison{
 				Left: Value{
 					Path: &Path{
 						Fields: []Field{
@@ -67,13 +68,13 @@ func Test_newConditionEvaluator(t *testing.T) {
 				Right: Value{
 					String: tqltest.Strp("bear"),
 				},
-				Op: "==",
+				Op: EQ,
 			},
 			item: "bear",
 		},
 		{
 			name: "path expression not matches",
-			cond: &Condition{
+			comparison: &Comparison{
 				Left: Value{
 					Path: &Path{
 						Fields: []Field{
@@ -86,18 +87,33 @@ func Test_newConditionEvaluator(t *testing.T) {
 				Right: Value{
 					String: tqltest.Strp("cat"),
 				},
-				Op: "!=",
+				Op: NE,
 			},
 			item: "bear",
 		},
 		{
-			name: "no condition",
-			cond: nil,
+			name: "path expression greater than",
+			comparison: &Comparison{
+				Left: Value{
+					Path: &Path{
+						Fields: []Field{
+							{
+								Name: "name",
+							},
+						},
+					},
+				},
+				Right: Value{
+					Float: tqltest.Floatp(1.5),
+				},
+				Op: GT,
+			},
+			item: int64(2),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			evaluate, err := newConditionEvaluator(tt.cond, DefaultFunctionsForTests(), testParsePath)
+			evaluate, err := newComparisonEvaluator(tt.comparison, DefaultFunctionsForTests(), testParsePath)
 			assert.NoError(t, err)
 			assert.True(t, evaluate(tqltest.TestTransformContext{
 				Item: tt.item,
@@ -106,11 +122,11 @@ func Test_newConditionEvaluator(t *testing.T) {
 	}
 
 	t.Run("invalid", func(t *testing.T) {
-		_, err := newConditionEvaluator(&Condition{
+		_, err := newComparisonEvaluator(&Comparison{
 			Left: Value{
 				String: tqltest.Strp("bear"),
 			},
-			Op: "<>",
+			Op: CompareOp(42),
 			Right: Value{
 				String: tqltest.Strp("cat"),
 			},
@@ -118,3 +134,107 @@ func Test_newConditionEvaluator(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_newBooleanExpressionEvaluator(t *testing.T) {
+	tests := []struct {
+		name  string
+		where string
+		item  interface{}
+		want  bool
+	}{
+		{
+			name:  "no condition",
+			where: "",
+			want:  true,
+		},
+		{
+			name:  "constant",
+			where: "false",
+			want:  false,
+		},
+		{
+			name:  "and",
+			where: `name == "bear" and name != "cat"`,
+			item:  "bear",
+			want:  true,
+		},
+		{
+			name:  "and with false",
+			where: `name == "bear" and false`,
+			item:  "bear",
+			want:  false,
+		},
+		{
+			name:  "or",
+			where: `name == "cat" or name == "bear"`,
+			item:  "bear",
+			want:  true,
+		},
+		{
+			name:  "or with no match",
+			where: `name == "cat" or name == "dog"`,
+			item:  "bear",
+			want:  false,
+		},
+		{
+			name:  "and takes precedence over or",
+			where: `true or true and false`,
+			want:  true,
+		},
+		{
+			name:  "parentheses",
+			where: `(true or true) and false`,
+			want:  false,
+		},
+		{
+			name:  "not",
+			where: `not name == "cat"`,
+			item:  "bear",
+			want:  true,
+		},
+		{
+			name:  "not applies to the next value only",
+			where: `not false and false`,
+			want:  false,
+		},
+		{
+			name:  "not with parentheses",
+			where: `not (false and false)`,
+			want:  true,
+		},
+		{
+			name:  "ordering",
+			where: `name >= 10 and name < 20.5`,
+			item:  int64(20),
+			want:  true,
+		},
+		{
+			name:  "ordering strings",
+			where: `name > "apple" and name <= "bear"`,
+			item:  "bear",
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := `set(name, "test")`
+			if tt.where != "" {
+				query += " where " + tt.where
+			}
+			parsed, err := parseQuery(query)
+			require.NoError(t, err)
+			evaluate, err := newBooleanExpressionEvaluator(parsed.WhereClause, DefaultFunctionsForTests(), testParsePath)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, evaluate(tqltest.TestTransformContext{
+				Item: tt.item,
+			}))
+		})
+	}
+}
+
+func Test_newBooleanExpressionEvaluator_invalid(t *testing.T) {
+	parsed, err := parseQuery(`set(name, "test") where true and unknown == "bear"`)
+	require.NoError(t, err)
+	_, err = newBooleanExpressionEvaluator(parsed.WhereClause, DefaultFunctionsForTests(), testParsePath)
+	assert.Error(t, err)
+}
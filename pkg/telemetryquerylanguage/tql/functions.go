@@ -48,7 +48,7 @@ func buildArgs(inv Invocation, fType reflect.Type, functions map[string]interfac
 		argType := fType.In(i)
 
 		if argType.Kind() == reflect.Slice {
-			err := buildSliceArg(inv, argType, i, &args)
+			err := buildSliceArg(inv, argType, i, &args, functions, pathParser)
 			if err != nil {
 				return nil, err
 			}
@@ -67,7 +67,8 @@ func buildArgs(inv Invocation, fType reflect.Type, functions map[string]interfac
 	return args, nil
 }
 
-func buildSliceArg(inv Invocation, argType reflect.Type, startingIndex int, args *[]reflect.Value) error {
+func buildSliceArg(inv Invocation, argType reflect.Type, startingIndex int, args *[]reflect.Value,
+	functions map[string]interface{}, pathParser PathExpressionParser) error {
 	switch argType.Elem().Kind() {
 	case reflect.String:
 		arg := make([]string, 0)
@@ -101,6 +102,19 @@ func buildSliceArg(inv Invocation, argType reflect.Type, startingIndex int, args
 			return fmt.Errorf("invalid argument for slice parameter at position %v, must be a byte slice literal", startingIndex)
 		}
 		*args = append(*args, reflect.ValueOf(([]byte)(*inv.Arguments[startingIndex].Bytes)))
+	case reflect.Interface:
+		if argType.Elem().Name() != "Getter" {
+			return fmt.Errorf("unsupported slice type for function %v", inv.Function)
+		}
+		arg := make([]Getter, 0)
+		for j := startingIndex; j < len(inv.Arguments); j++ {
+			val, err := NewGetter(inv.Arguments[j], functions, pathParser)
+			if err != nil {
+				return fmt.Errorf("invalid argument for slice parameter at position %v %w", j, err)
+			}
+			arg = append(arg, val)
+		}
+		*args = append(*args, reflect.ValueOf(arg))
 	default:
 		return fmt.Errorf("unsupported slice type for function %v", inv.Function)
 	}
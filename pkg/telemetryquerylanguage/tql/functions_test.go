@@ -31,6 +31,7 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 	functions["testing_multiple_args"] = functionWithMultipleArgs
 	functions["testing_string"] = functionWithString
 	functions["testing_byte_slice"] = functionWithByteSlice
+	functions["testing_getter_slice"] = functionWithGetterSlice
 
 	tests := []struct {
 		name string
@@ -115,6 +116,22 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid getter in getter slice",
+			inv: Invocation{
+				Function: "testing_getter_slice",
+				Arguments: []Value{
+					{
+						String: tqltest.Strp("test"),
+					},
+					{
+						Invocation: &Invocation{
+							Function: "unknownfunc",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "function call returns error",
 			inv: Invocation{
@@ -171,6 +188,39 @@ func Test_NewFunctionCall(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "getter slice arg",
+			inv: Invocation{
+				Function: "testing_getter_slice",
+				Arguments: []Value{
+					{
+						Path: &Path{
+							Fields: []Field{
+								{
+									Name: "name",
+								},
+							},
+						},
+					},
+					{
+						String: tqltest.Strp("test"),
+					},
+					{
+						Int: tqltest.Intp(1),
+					},
+					{
+						Invocation: &Invocation{
+							Function: "testing_getter",
+							Arguments: []Value{
+								{
+									String: tqltest.Strp("test"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "int slice arg",
 			inv: Invocation{
@@ -370,6 +420,12 @@ func functionWithByteSlice(_ []byte) (ExprFunc, error) {
 	}, nil
 }
 
+func functionWithGetterSlice(_ []Getter) (ExprFunc, error) {
+	return func(ctx TransformContext) interface{} {
+		return "anything"
+	}, nil
+}
+
 func functionWithSetter(_ Setter) (ExprFunc, error) {
 	return func(ctx TransformContext) interface{} {
 		return "anything"
@@ -431,6 +487,7 @@ func DefaultFunctionsForTests() map[string]interface{} {
 	functions["testing_float_slice"] = functionWithFloatSlice
 	functions["testing_int_slice"] = functionWithIntSlice
 	functions["testing_byte_slice"] = functionWithByteSlice
+	functions["testing_getter_slice"] = functionWithGetterSlice
 	functions["testing_setter"] = functionWithSetter
 	functions["testing_getsetter"] = functionWithGetSetter
 	functions["testing_getter"] = functionWithGetter
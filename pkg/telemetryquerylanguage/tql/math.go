@@ -0,0 +1,165 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tql // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+
+import (
+	"fmt"
+	"time"
+)
+
+func newMathGetter(expr *MathExpression, functions map[string]interface{}, pathParser PathExpressionParser) (Getter, error) {
+	left, err := newAddSubTermGetter(expr.Left, functions, pathParser)
+	if err != nil {
+		return nil, err
+	}
+	for _, rhs := range expr.Right {
+		right, err := newAddSubTermGetter(rhs.Term, functions, pathParser)
+		if err != nil {
+			return nil, err
+		}
+		left = newMathOperationGetter(left, rhs.Operator, right)
+	}
+	return left, nil
+}
+
+func newAddSubTermGetter(term *AddSubTerm, functions map[string]interface{}, pathParser PathExpressionParser) (Getter, error) {
+	left, err := newMathValueGetter(term.Left, functions, pathParser)
+	if err != nil {
+		return nil, err
+	}
+	for _, rhs := range term.Right {
+		right, err := newMathValueGetter(rhs.Value, functions, pathParser)
+		if err != nil {
+			return nil, err
+		}
+		left = newMathOperationGetter(left, rhs.Operator, right)
+	}
+	return left, nil
+}
+
+func newMathValueGetter(value *MathValue, functions map[string]interface{}, pathParser PathExpressionParser) (Getter, error) {
+	if value.SubExpression != nil {
+		return newMathGetter(value.SubExpression, functions, pathParser)
+	}
+	if value.Literal == nil {
+		// In practice, can't happen since the DSL grammar guarantees one is set
+		return nil, fmt.Errorf("no math value field set. This is a bug in the telemetry query language")
+	}
+	return NewGetter(Value{
+		Invocation: value.Literal.Invocation,
+		Float:      value.Literal.Float,
+		Int:        value.Literal.Int,
+		Path:       value.Literal.Path,
+	}, functions, pathParser)
+}
+
+func newMathOperationGetter(left Getter, op MathOp, right Getter) Getter {
+	return &exprGetter{
+		expr: func(ctx TransformContext) interface{} {
+			return performMath(left.Get(ctx), op, right.Get(ctx))
+		},
+	}
+}
+
+// performMath applies op to a and b. Ints stay ints, unless they are combined with a float. Times and durations
+// can be subtracted and added to each other, and durations can be multiplied and divided by ints. The result is
+// nil for any other value, and for ints divided by zero.
+func performMath(a interface{}, op MathOp, b interface{}) interface{} {
+	switch av := a.(type) {
+	case int64:
+		switch bv := b.(type) {
+		case int64:
+			return performIntMath(av, op, bv)
+		case float64:
+			return performFloatMath(float64(av), op, bv)
+		}
+	case float64:
+		switch bv := b.(type) {
+		case int64:
+			return performFloatMath(av, op, float64(bv))
+		case float64:
+			return performFloatMath(av, op, bv)
+		}
+	case time.Time:
+		switch bv := b.(type) {
+		case time.Time:
+			if op == SUB {
+				return av.Sub(bv)
+			}
+		case time.Duration:
+			switch op {
+			case ADD:
+				return av.Add(bv)
+			case SUB:
+				return av.Add(-bv)
+			}
+		}
+	case time.Duration:
+		switch bv := b.(type) {
+		case time.Duration:
+			switch op {
+			case ADD:
+				return av + bv
+			case SUB:
+				return av - bv
+			}
+		case time.Time:
+			if op == ADD {
+				return bv.Add(av)
+			}
+		case int64:
+			switch op {
+			case MULT:
+				return av * time.Duration(bv)
+			case DIV:
+				if bv != 0 {
+					return av / time.Duration(bv)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func performIntMath(a int64, op MathOp, b int64) interface{} {
+	switch op {
+	case ADD:
+		return a + b
+	case SUB:
+		return a - b
+	case MULT:
+		return a * b
+	case DIV:
+		if b == 0 {
+			return nil
+		}
+		return a / b
+	}
+	return nil
+}
+
+func performFloatMath(a float64, op MathOp, b float64) interface{} {
+	switch op {
+	case ADD:
+		return a + b
+	case SUB:
+		return a - b
+	case MULT:
+		return a * b
+	case DIV:
+		return a / b
+	}
+	return nil
+}
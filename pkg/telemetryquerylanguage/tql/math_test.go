@@ -0,0 +1,190 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tql
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql/tqltest"
+)
+
+func fortyTwo() (ExprFunc, error) {
+	return func(ctx TransformContext) interface{} {
+		return int64(42)
+	}, nil
+}
+
+func Test_newMathGetter(t *testing.T) {
+	functions := map[string]interface{}{"forty_two": fortyTwo}
+	tests := []struct {
+		name     string
+		value    string
+		item     interface{}
+		expected interface{}
+	}{
+		{
+			name:     "addition",
+			value:    "1 + 2",
+			expected: int64(3),
+		},
+		{
+			name:     "subtraction",
+			value:    "1 - 2",
+			expected: int64(-1),
+		},
+		{
+			name:     "subtraction without spaces",
+			value:    "1-2",
+			expected: int64(-1),
+		},
+		{
+			name:     "negative operand",
+			value:    "1 - -2",
+			expected: int64(3),
+		},
+		{
+			name:     "multiplication takes precedence",
+			value:    "1 + 2 * 3",
+			expected: int64(7),
+		},
+		{
+			name:     "division takes precedence",
+			value:    "10 - 6 / 2",
+			expected: int64(7),
+		},
+		{
+			name:     "left associativity",
+			value:    "10 - 4 - 3",
+			expected: int64(3),
+		},
+		{
+			name:     "left associativity of division",
+			value:    "100 / 10 / 5",
+			expected: int64(2),
+		},
+		{
+			name:     "parentheses",
+			value:    "(1 + 2) * 3",
+			expected: int64(9),
+		},
+		{
+			name:     "nested parentheses",
+			value:    "((1 + 2) * (3 - 1)) / 4",
+			expected: int64(1),
+		},
+		{
+			name:     "int division truncates",
+			value:    "7 / 2",
+			expected: int64(3),
+		},
+		{
+			name:     "int and float",
+			value:    "7 / 2.0",
+			expected: 3.5,
+		},
+		{
+			name:     "floats",
+			value:    "1.5 * 2.5 + .25",
+			expected: 4.0,
+		},
+		{
+			name:     "int division by zero",
+			value:    "1 / 0",
+			expected: nil,
+		},
+		{
+			name:     "float division by zero",
+			value:    "1.0 / 0",
+			expected: math.Inf(1),
+		},
+		{
+			name:     "path",
+			value:    "(name - 1000000) / 1000000",
+			item:     int64(5000000),
+			expected: int64(4),
+		},
+		{
+			name:     "path with another type",
+			value:    "name + 1",
+			item:     "bear",
+			expected: nil,
+		},
+		{
+			name:     "invocation",
+			value:    "forty_two() / 2",
+			expected: int64(21),
+		},
+		{
+			name:     "durations",
+			value:    "name * 2",
+			item:     time.Second,
+			expected: 2 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := parseQuery("set(name, " + tt.value + ")")
+			require.NoError(t, err)
+			require.NotNil(t, parsed.Invocation.Arguments[1].MathExpression)
+			getter, err := NewGetter(parsed.Invocation.Arguments[1], functions, testParsePath)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, getter.Get(tqltest.TestTransformContext{
+				Item: tt.item,
+			}))
+		})
+	}
+}
+
+func Test_newMathGetter_invalid(t *testing.T) {
+	parsed, err := parseQuery("set(name, 1 + unknown)")
+	require.NoError(t, err)
+	_, err = NewGetter(parsed.Invocation.Arguments[1], DefaultFunctionsForTests(), testParsePath)
+	assert.Error(t, err)
+}
+
+func Test_performMath(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		a        interface{}
+		op       MathOp
+		b        interface{}
+		expected interface{}
+	}{
+		{"ints", int64(6), MULT, int64(7), int64(42)},
+		{"int and float", int64(1), ADD, 0.5, 1.5},
+		{"float and int", 1.5, SUB, int64(1), 0.5},
+		{"times", now.Add(time.Minute), SUB, now, time.Minute},
+		{"time plus duration", now, ADD, time.Minute, now.Add(time.Minute)},
+		{"duration plus time", time.Minute, ADD, now, now.Add(time.Minute)},
+		{"time minus duration", now, SUB, time.Minute, now.Add(-time.Minute)},
+		{"durations", time.Minute, SUB, time.Second, 59 * time.Second},
+		{"duration divided by int", time.Minute, DIV, int64(60), time.Second},
+		{"duration divided by zero", time.Minute, DIV, int64(0), nil},
+		{"times can't be added", now, ADD, now, nil},
+		{"strings", "a", ADD, "b", nil},
+		{"nil", nil, ADD, int64(1), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, performMath(tt.a, tt.op, tt.b))
+		})
+	}
+}
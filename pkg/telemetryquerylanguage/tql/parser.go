@@ -16,6 +16,7 @@ package tql // import "github.com/open-telemetry/opentelemetry-collector-contrib
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/participle/v2/lexer"
@@ -25,16 +26,87 @@ import (
 // ParsedQuery represents a parsed query. It is the entry point into the query DSL.
 // nolint:govet
 type ParsedQuery struct {
-	Invocation Invocation `@@`
-	Condition  *Condition `( "where" @@ )?`
+	Invocation  Invocation         `@@`
+	WhereClause *BooleanExpression `( "where" @@ )?`
 }
 
-// Condition represents an optional boolean condition on the RHS of a query.
+// ParsedCondition represents a parsed condition. It is the entry point into the DSL for conditions used on their own,
+// without a function invocation.
 // nolint:govet
-type Condition struct {
-	Left  Value  `@@`
-	Op    string `@("==" | "!=")`
-	Right Value  `@@`
+type ParsedCondition struct {
+	Condition *BooleanExpression `@@`
+}
+
+// BooleanExpression represents an optional boolean condition on the RHS of a query. It is made of one or more
+// Terms joined by "or".
+// nolint:govet
+type BooleanExpression struct {
+	Left  *Term   `@@`
+	Right []*Term `( "or" @@ )*`
+}
+
+// Term is made of one or more BooleanValues joined by "and", so that "and" takes precedence over "or".
+// nolint:govet
+type Term struct {
+	Left  *BooleanValue   `@@`
+	Right []*BooleanValue `( "and" @@ )*`
+}
+
+// BooleanValue represents something that evaluates to true or false: a Comparison, a boolean literal, or a
+// BooleanExpression between parentheses. It is negated when preceded by "not".
+// nolint:govet
+type BooleanValue struct {
+	Negation   bool               `@"not"?`
+	Comparison *Comparison        `( @@`
+	ConstExpr  *Boolean           `| @("true" | "false")`
+	SubExpr    *BooleanExpression `| "(" @@ ")" )`
+}
+
+// Comparison compares a left Value with a right Value.
+// nolint:govet
+type Comparison struct {
+	Left  Value     `@@`
+	Op    CompareOp `@OpComparison`
+	Right Value     `@@`
+}
+
+// CompareOp is the operator of a Comparison.
+type CompareOp int
+
+const (
+	EQ CompareOp = iota
+	NE
+	LT
+	LTE
+	GTE
+	GT
+)
+
+var compareOpTable = map[string]CompareOp{
+	"==": EQ,
+	"!=": NE,
+	"<":  LT,
+	"<=": LTE,
+	">=": GTE,
+	">":  GT,
+}
+
+func (c *CompareOp) Capture(values []string) error {
+	op, ok := compareOpTable[values[0]]
+	if !ok {
+		return fmt.Errorf("'%s' is not a valid comparison operator", values[0])
+	}
+	*c = op
+	return nil
+}
+
+func (c CompareOp) String() string {
+	for str, op := range compareOpTable {
+		if op == c {
+			return str
+		}
+	}
+	return ""
 }
 
 // Invocation represents a function call.
@@ -45,30 +117,120 @@ type Invocation struct {
 }
 
 // Value represents a part of a parsed query which is resolved to a value of some sort. This can be a telemetry path
-// expression, function call, or literal.
+// expression, function call, literal, or a math expression combining them. Invocations, numbers and paths followed
+// by a math operator are parsed as a MathExpression. A Path can't be followed by "(", so that the function name of an
+// Invocation used in a MathExpression isn't parsed as a Path.
 // nolint:govet
 type Value struct {
+	Invocation     *Invocation     `( @@ (?! OpAddSub | OpMultDiv)`
+	Bytes          *Bytes          `| @Bytes`
+	String         *string         `| @String`
+	Float          *float64        `| @(("+" | "-")? Float) (?! OpAddSub | OpMultDiv)`
+	Int            *int64          `| @(("+" | "-")? Int) (?! OpAddSub | OpMultDiv)`
+	Bool           *Boolean        `| @("true" | "false")`
+	IsNil          *IsNil          `| @"nil"`
+	Path           *Path           `| @@ (?! OpAddSub | OpMultDiv | "(")`
+	MathExpression *MathExpression `| @@ )`
+}
+
+// MathExpression represents one or more Terms joined by "+" or "-".
+// nolint:govet
+type MathExpression struct {
+	Left  *AddSubTerm     `@@`
+	Right []*OpAddSubTerm `@@*`
+}
+
+// OpAddSubTerm is a Term preceded by "+" or "-".
+// nolint:govet
+type OpAddSubTerm struct {
+	Operator MathOp      `@OpAddSub`
+	Term     *AddSubTerm `@@`
+}
+
+// AddSubTerm is made of one or more MathValues joined by "*" or "/", so that they take precedence over "+" and "-".
+// nolint:govet
+type AddSubTerm struct {
+	Left  *MathValue        `@@`
+	Right []*OpMultDivValue `@@*`
+}
+
+// OpMultDivValue is a MathValue preceded by "*" or "/".
+// nolint:govet
+type OpMultDivValue struct {
+	Operator MathOp     `@OpMultDiv`
+	Value    *MathValue `@@`
+}
+
+// MathValue is an operand of a MathExpression: a literal, or a MathExpression between parentheses.
+// nolint:govet
+type MathValue struct {
+	Literal       *MathExprLiteral `( @@`
+	SubExpression *MathExpression  `| "(" @@ ")" )`
+}
+
+// MathExprLiteral represents the Values that can be used in a MathExpression.
+// nolint:govet
+type MathExprLiteral struct {
 	Invocation *Invocation `( @@`
-	Bytes      *Bytes      `| @Bytes`
-	String     *string     `| @String`
-	Float      *float64    `| @Float`
-	Int        *int64      `| @Int`
-	Bool       *Boolean    `| @("true" | "false")`
-	IsNil      *IsNil      `| @"nil"`
+	Float      *float64    `| @(("+" | "-")? Float)`
+	Int        *int64      `| @(("+" | "-")? Int)`
 	Path       *Path       `| @@ )`
 }
 
+// MathOp is the operator of a MathExpression.
+type MathOp int
+
+const (
+	ADD MathOp = iota
+	SUB
+	MULT
+	DIV
+)
+
+var mathOpTable = map[string]MathOp{
+	"+": ADD,
+	"-": SUB,
+	"*": MULT,
+	"/": DIV,
+}
+
+func (m *MathOp) Capture(values []string) error {
+	op, ok := mathOpTable[values[0]]
+	if !ok {
+		return fmt.Errorf("'%s' is not a valid math operator", values[0])
+	}
+	*m = op
+	return nil
+}
+
+func (m MathOp) String() string {
+	for str, op := range mathOpTable {
+		if op == m {
+			return str
+		}
+	}
+	return ""
+}
+
 // Path represents a telemetry path expression.
 // nolint:govet
 type Path struct {
 	Fields []Field `@@ ( "." @@ )*`
 }
 
-// Field is an item within a Path.
+// Field is an item within a Path. A Field can be followed by any number of Keys, which index into maps or slices,
+// e.g. attributes["tags"][0].
 // nolint:govet
 type Field struct {
-	Name   string  `@Ident`
-	MapKey *string `( "[" @String "]" )?`
+	Name string `@Ident`
+	Keys []Key  `( @@ )*`
+}
+
+// Key is a map key or a slice index used to access a value within a Field.
+// nolint:govet
+type Key struct {
+	String *string `"[" ( @String `
+	Int    *int64  `| @Int ) "]"`
 }
 
 // Query holds a top level Query for processing telemetry data. A Query is a combination of a function
@@ -122,7 +284,7 @@ func ParseQueries(statements []string, functions map[string]interface{}, pathPar
 			errors = multierr.Append(errors, err)
 			continue
 		}
-		condition, err := newConditionEvaluator(parsed.Condition, functions, pathParser)
+		condition, err := newBooleanExpressionEvaluator(parsed.WhereClause, functions, pathParser)
 		if err != nil {
 			errors = multierr.Append(errors, err)
 			continue
@@ -139,7 +301,47 @@ func ParseQueries(statements []string, functions map[string]interface{}, pathPar
 	return queries, nil
 }
 
-var parser = newParser()
+// ParseConditions parses conditions used on their own, such as `name == "bear" and attributes["test"] != nil`.
+func ParseConditions(conditions []string, functions map[string]interface{}, pathParser PathExpressionParser) ([]CondFunc, error) {
+	condFuncs := make([]CondFunc, 0)
+	var errors error
+
+	for _, condition := range conditions {
+		parsed, err := parseCondition(condition)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		condFunc, err := newBooleanExpressionEvaluator(parsed.Condition, functions, pathParser)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		condFuncs = append(condFuncs, condFunc)
+	}
+
+	if errors != nil {
+		return nil, errors
+	}
+	return condFuncs, nil
+}
+
+// MatchesAnyCondition returns true if any of the conditions is true in the given context.
+func MatchesAnyCondition(conditions []CondFunc, ctx TransformContext) bool {
+	for _, condition := range conditions {
+		if condition(ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxLookahead is the number of tokens the parser looks ahead to pick between alternatives of the grammar.
+const maxLookahead = 10000
+
+var parser = newParser(&ParsedQuery{})
+
+var conditionParser = newParser(&ParsedCondition{})
 
 func parseQuery(raw string) (*ParsedQuery, error) {
 	parsed := &ParsedQuery{}
@@ -150,22 +352,40 @@ func parseQuery(raw string) (*ParsedQuery, error) {
 	return parsed, nil
 }
 
-// newParser returns a parser that can be used to read a string into a ParsedQuery. An error will be returned if the string
-// is not formatted for the DSL.
-func newParser() *participle.Parser {
+func parseCondition(raw string) (*ParsedCondition, error) {
+	parsed := &ParsedCondition{}
+	err := conditionParser.ParseString("", raw, parsed)
+	if err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// newParser returns a parser that can be used to read a string into the grammar, a ParsedQuery or a ParsedCondition.
+// An error will be returned if the string is not formatted for the DSL.
+func newParser(grammar interface{}) *participle.Parser {
 	lex := lexer.MustSimple([]lexer.SimpleRule{
+		{Name: `OpNot`, Pattern: `\b(not)\b`},
+		{Name: `OpOr`, Pattern: `\b(or)\b`},
+		{Name: `OpAnd`, Pattern: `\b(and)\b`},
 		{Name: `Ident`, Pattern: `[a-zA-Z_][a-zA-Z0-9_]*`},
 		{Name: `Bytes`, Pattern: `0x[a-fA-F0-9]+`},
-		{Name: `Float`, Pattern: `[-+]?\d*\.\d+([eE][-+]?\d+)?`},
-		{Name: `Int`, Pattern: `[-+]?\d+`},
+		{Name: `Float`, Pattern: `\d*\.\d+([eE][-+]?\d+)?`},
+		{Name: `Int`, Pattern: `\d+`},
 		{Name: `String`, Pattern: `"(\\"|[^"])*"`},
-		{Name: `Operators`, Pattern: `==|!=|[,.()\[\]]`},
+		{Name: `OpComparison`, Pattern: `==|!=|>=|<=|>|<`},
+		{Name: `OpAddSub`, Pattern: `\+|\-`},
+		{Name: `OpMultDiv`, Pattern: `\/|\*`},
+		{Name: `Operators`, Pattern: `[,.()\[\]]`},
 		{Name: "whitespace", Pattern: `\s+`},
 	})
-	parser, err := participle.Build(&ParsedQuery{},
+	parser, err := participle.Build(grammar,
 		participle.Lexer(lex),
 		participle.Unquote("String"),
 		participle.Elide("whitespace"),
+		// Invocations, numbers and paths can only be told apart from MathExpressions, and Comparisons from
+		// BooleanExpressions between parentheses, once they have been parsed entirely.
+		participle.UseLookahead(maxLookahead),
 	)
 	if err != nil {
 		panic("Unable to initialize parser, this is a programming error in the transformprocesor")
@@ -39,7 +39,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -53,7 +53,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -67,7 +67,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -100,7 +100,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -116,8 +116,12 @@ func Test_parse(t *testing.T) {
 										Name: "foo",
 									},
 									{
-										Name:   "attributes",
-										MapKey: tqltest.Strp("bar"),
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("bar"),
+											},
+										},
 									},
 									{
 										Name: "cat",
@@ -130,7 +134,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -146,8 +150,12 @@ func Test_parse(t *testing.T) {
 										Name: "foo",
 									},
 									{
-										Name:   "attributes",
-										MapKey: tqltest.Strp("bar"),
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("bar"),
+											},
+										},
 									},
 									{
 										Name: "cat",
@@ -160,20 +168,26 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: &Condition{
-					Left: Value{
-						Path: &Path{
-							Fields: []Field{
-								{
-									Name: "name",
+				WhereClause: &BooleanExpression{
+					Left: &Term{
+						Left: &BooleanValue{
+							Comparison: &Comparison{
+								Left: Value{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+								Op: EQ,
+								Right: Value{
+									String: tqltest.Strp("fido"),
 								},
 							},
 						},
 					},
-					Op: "==",
-					Right: Value{
-						String: tqltest.Strp("fido"),
-					},
 				},
 			},
 		},
@@ -190,8 +204,12 @@ func Test_parse(t *testing.T) {
 										Name: "foo",
 									},
 									{
-										Name:   "attributes",
-										MapKey: tqltest.Strp("bar"),
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("bar"),
+											},
+										},
 									},
 									{
 										Name: "cat",
@@ -204,20 +222,26 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: &Condition{
-					Left: Value{
-						Path: &Path{
-							Fields: []Field{
-								{
-									Name: "name",
+				WhereClause: &BooleanExpression{
+					Left: &Term{
+						Left: &BooleanValue{
+							Comparison: &Comparison{
+								Left: Value{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+								Op: NE,
+								Right: Value{
+									String: tqltest.Strp("fido"),
 								},
 							},
 						},
 					},
-					Op: "!=",
-					Right: Value{
-						String: tqltest.Strp("fido"),
-					},
 				},
 			},
 		},
@@ -234,8 +258,12 @@ func Test_parse(t *testing.T) {
 										Name: "foo",
 									},
 									{
-										Name:   "attributes",
-										MapKey: tqltest.Strp("bar"),
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("bar"),
+											},
+										},
 									},
 									{
 										Name: "cat",
@@ -248,20 +276,26 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: &Condition{
-					Left: Value{
-						Path: &Path{
-							Fields: []Field{
-								{
-									Name: "name",
+				WhereClause: &BooleanExpression{
+					Left: &Term{
+						Left: &BooleanValue{
+							Comparison: &Comparison{
+								Left: Value{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+								Op: EQ,
+								Right: Value{
+									String: tqltest.Strp("fido"),
 								},
 							},
 						},
 					},
-					Op: "==",
-					Right: Value{
-						String: tqltest.Strp("fido"),
-					},
 				},
 			},
 		},
@@ -276,7 +310,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -293,7 +327,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -310,7 +344,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -323,8 +357,12 @@ func Test_parse(t *testing.T) {
 							Path: &Path{
 								Fields: []Field{
 									{
-										Name:   "attributes",
-										MapKey: tqltest.Strp("bytes"),
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("bytes"),
+											},
+										},
 									},
 								},
 							},
@@ -334,7 +372,7 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
 			},
 		},
 		{
@@ -347,8 +385,12 @@ func Test_parse(t *testing.T) {
 							Path: &Path{
 								Fields: []Field{
 									{
-										Name:   "attributes",
-										MapKey: tqltest.Strp("test"),
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("test"),
+											},
+										},
 									},
 								},
 							},
@@ -358,7 +400,157 @@ func Test_parse(t *testing.T) {
 						},
 					},
 				},
-				Condition: nil,
+				WhereClause: nil,
+			},
+		},
+		{
+			query: `set(attributes["tags"][0], body["http"]["status"])`,
+			expected: &ParsedQuery{
+				Invocation: Invocation{
+					Function: "set",
+					Arguments: []Value{
+						{
+							Path: &Path{
+								Fields: []Field{
+									{
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("tags"),
+											},
+											{
+												Int: tqltest.Intp(0),
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Path: &Path{
+								Fields: []Field{
+									{
+										Name: "body",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("http"),
+											},
+											{
+												String: tqltest.Strp("status"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			query: `set(attributes["test"], -1)`,
+			expected: &ParsedQuery{
+				Invocation: Invocation{
+					Function: "set",
+					Arguments: []Value{
+						{
+							Path: &Path{
+								Fields: []Field{
+									{
+										Name: "attributes",
+										Keys: []Key{
+											{
+												String: tqltest.Strp("test"),
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Int: tqltest.Intp(-1),
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			query: `set(name, 1 + 2.5 * (name - 3))`,
+			expected: &ParsedQuery{
+				Invocation: Invocation{
+					Function: "set",
+					Arguments: []Value{
+						{
+							Path: &Path{
+								Fields: []Field{
+									{
+										Name: "name",
+									},
+								},
+							},
+						},
+						{
+							MathExpression: &MathExpression{
+								Left: &AddSubTerm{
+									Left: &MathValue{
+										Literal: &MathExprLiteral{
+											Int: tqltest.Intp(1),
+										},
+									},
+								},
+								Right: []*OpAddSubTerm{
+									{
+										Operator: ADD,
+										Term: &AddSubTerm{
+											Left: &MathValue{
+												Literal: &MathExprLiteral{
+													Float: tqltest.Floatp(2.5),
+												},
+											},
+											Right: []*OpMultDivValue{
+												{
+													Operator: MULT,
+													Value: &MathValue{
+														SubExpression: &MathExpression{
+															Left: &AddSubTerm{
+																Left: &MathValue{
+																	Literal: &MathExprLiteral{
+																		Path: &Path{
+																			Fields: []Field{
+																				{
+																					Name: "name",
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+															Right: []*OpAddSubTerm{
+																{
+																	Operator: SUB,
+																	Term: &AddSubTerm{
+																		Left: &MathValue{
+																			Literal: &MathExprLiteral{
+																				Int: tqltest.Intp(3),
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				WhereClause: nil,
 			},
 		},
 	}
@@ -372,6 +564,245 @@ func Test_parse(t *testing.T) {
 	}
 }
 
+func Test_parseWhere(t *testing.T) {
+	tests := []struct {
+		where    string
+		expected *BooleanExpression
+	}{
+		{
+			where: `true`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{ConstExpr: booleanp(true)},
+				},
+			},
+		},
+		{
+			where: `name > 1`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{Comparison: pathComparison("name", GT, Value{Int: tqltest.Intp(1)})},
+				},
+			},
+		},
+		{
+			where: `name <= 1.5 and name >= "a" and name < name`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{Comparison: pathComparison("name", LTE, Value{Float: tqltest.Floatp(1.5)})},
+					Right: []*BooleanValue{
+						{Comparison: pathComparison("name", GTE, Value{String: tqltest.Strp("a")})},
+						{Comparison: pathComparison("name", LT, Value{Path: &Path{Fields: []Field{{Name: "name"}}}})},
+					},
+				},
+			},
+		},
+		{
+			where: `name == "a" or name == "b" and false`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{Comparison: pathComparison("name", EQ, Value{String: tqltest.Strp("a")})},
+				},
+				Right: []*Term{
+					{
+						Left: &BooleanValue{Comparison: pathComparison("name", EQ, Value{String: tqltest.Strp("b")})},
+						Right: []*BooleanValue{
+							{ConstExpr: booleanp(false)},
+						},
+					},
+				},
+			},
+		},
+		{
+			where: `not (name == "a" or true) and not name != nil`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{
+						Negation: true,
+						SubExpr: &BooleanExpression{
+							Left: &Term{
+								Left: &BooleanValue{Comparison: pathComparison("name", EQ, Value{String: tqltest.Strp("a")})},
+							},
+							Right: []*Term{
+								{
+									Left: &BooleanValue{ConstExpr: booleanp(true)},
+								},
+							},
+						},
+					},
+					Right: []*BooleanValue{
+						{
+							Negation:   true,
+							Comparison: pathComparison("name", NE, Value{IsNil: (*IsNil)(tqltest.Boolp(true))}),
+						},
+					},
+				},
+			},
+		},
+		{
+			where: `((name=="a"))`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{
+						SubExpr: &BooleanExpression{
+							Left: &Term{
+								Left: &BooleanValue{
+									SubExpr: &BooleanExpression{
+										Left: &Term{
+											Left: &BooleanValue{Comparison: pathComparison("name", EQ, Value{String: tqltest.Strp("a")})},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			where: `(name - 1) * 2 > name`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{
+						Comparison: &Comparison{
+							Left: Value{
+								MathExpression: &MathExpression{
+									Left: &AddSubTerm{
+										Left: &MathValue{
+											SubExpression: &MathExpression{
+												Left: &AddSubTerm{
+													Left: &MathValue{Literal: &MathExprLiteral{Path: &Path{Fields: []Field{{Name: "name"}}}}},
+												},
+												Right: []*OpAddSubTerm{
+													{
+														Operator: SUB,
+														Term: &AddSubTerm{
+															Left: &MathValue{Literal: &MathExprLiteral{Int: tqltest.Intp(1)}},
+														},
+													},
+												},
+											},
+										},
+										Right: []*OpMultDivValue{
+											{
+												Operator: MULT,
+												Value:    &MathValue{Literal: &MathExprLiteral{Int: tqltest.Intp(2)}},
+											},
+										},
+									},
+								},
+							},
+							Op:    GT,
+							Right: Value{Path: &Path{Fields: []Field{{Name: "name"}}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.where, func(t *testing.T) {
+			parsed, err := parseQuery(`set(name, "test") where ` + tt.where)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, parsed.WhereClause)
+		})
+	}
+}
+
+func Test_parseCondition(t *testing.T) {
+	tests := []struct {
+		condition string
+		expected  *BooleanExpression
+	}{
+		{
+			condition: `name == "bear"`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{
+						Comparison: pathComparison("name", EQ, Value{String: tqltest.Strp("bear")}),
+					},
+				},
+			},
+		},
+		{
+			condition: `true or name != "bear"`,
+			expected: &BooleanExpression{
+				Left: &Term{
+					Left: &BooleanValue{
+						ConstExpr: booleanp(true),
+					},
+				},
+				Right: []*Term{
+					{
+						Left: &BooleanValue{
+							Comparison: pathComparison("name", NE, Value{String: tqltest.Strp("bear")}),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			parsed, err := parseCondition(tt.condition)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, parsed.Condition)
+		})
+	}
+}
+
+func Test_parseCondition_failure(t *testing.T) {
+	tests := []string{
+		``,
+		`name`,
+		`set(name, "bear")`,
+		`set(name, "bear") where name == "bear"`,
+		`name == "bear" and`,
+		`where name == "bear"`,
+	}
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			_, err := parseCondition(tt)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_ParseConditions(t *testing.T) {
+	conditions, err := ParseConditions([]string{`name == "bear"`, `name == "cat" or name == "dog"`}, DefaultFunctionsForTests(), testParsePath)
+	assert.NoError(t, err)
+	assert.Len(t, conditions, 2)
+	assert.True(t, conditions[0](tqltest.TestTransformContext{Item: "bear"}))
+	assert.False(t, conditions[1](tqltest.TestTransformContext{Item: "bear"}))
+	assert.True(t, conditions[1](tqltest.TestTransformContext{Item: "dog"}))
+
+	_, err = ParseConditions([]string{`name == "bear"`, `unknown == "bear"`, `name ==`}, DefaultFunctionsForTests(), testParsePath)
+	assert.Error(t, err)
+}
+
+func Test_MatchesAnyCondition(t *testing.T) {
+	conditions, err := ParseConditions([]string{`name == "bear"`, `name == "cat"`}, DefaultFunctionsForTests(), testParsePath)
+	assert.NoError(t, err)
+	assert.True(t, MatchesAnyCondition(conditions, tqltest.TestTransformContext{Item: "bear"}))
+	assert.True(t, MatchesAnyCondition(conditions, tqltest.TestTransformContext{Item: "cat"}))
+	assert.False(t, MatchesAnyCondition(conditions, tqltest.TestTransformContext{Item: "dog"}))
+	assert.False(t, MatchesAnyCondition(nil, tqltest.TestTransformContext{Item: "bear"}))
+}
+
+func booleanp(b bool) *Boolean {
+	return (*Boolean)(&b)
+}
+
+func pathComparison(name string, op CompareOp, right Value) *Comparison {
+	return &Comparison{
+		Left:  Value{Path: &Path{Fields: []Field{{Name: name}}}},
+		Op:    op,
+		Right: right,
+	}
+}
+
 func Test_parse_failure(t *testing.T) {
 	tests := []string{
 		`set(`,
@@ -379,6 +810,19 @@ func Test_parse_failure(t *testing.T) {
 		`set(name.)`,
 		`("foo")`,
 		`set("foo") where name =||= "fido"`,
+		`set("foo") where name => "fido"`,
+		`set("foo") where name == "fido" and`,
+		`set("foo") where (name == "fido"`,
+		`set("foo") where name == "fido" not name == "dog"`,
+		`set("foo") where name`,
+		`set(name, 1 +)`,
+		`set(name, (1 + 2)`,
+		`set(name, "a" + 1)`,
+		`set(name, 1 + "a")`,
+		`set(name, 1 * / 2)`,
+		`set(attributes[], 1)`,
+		`set(attributes[1.5], 1)`,
+		`set(attributes["a"][name], 1)`,
 		`set(span_id, SpanIDWrapper{not a hex string})`,
 		`set(span_id, SpanIDWrapper{01})`,
 		`set(span_id, SpanIDWrapper{010203040506070809})`,
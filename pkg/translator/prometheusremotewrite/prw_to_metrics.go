@@ -0,0 +1,533 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/timestamp"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/multierr"
+)
+
+// targetInfoMetricName is the name of the info metric holding the resource attributes of a target
+const targetInfoMetricName = "target_info"
+
+// ToMetrics converts a prometheus remote write request to pmetric.Metrics.
+//
+// Series are grouped into resources by their job and instance labels, and into metrics by their metric family. The
+// labels of the target_info series are added to the attributes of the resource they belong to. The metadata of the
+// request, when available, determines the type of each metric family: counters become cumulative monotonic sums,
+// histograms and summaries are rebuilt from their bucket, quantile, sum and count series, and all the other series,
+// including the ones without metadata, become gauges. Series that can't be translated are skipped, and the returned
+// error describes all of them.
+func ToMetrics(req *prompb.WriteRequest) (pmetric.Metrics, error) {
+	b := newMetricsBuilder(req.Metadata)
+
+	var errs error
+	for _, ts := range req.Timeseries {
+		errs = multierr.Append(errs, b.addTimeSeries(ts))
+	}
+	errs = multierr.Append(errs, b.build())
+
+	return b.md, errs
+}
+
+// metricsBuilder accumulates the series of a remote write request into pmetric.Metrics
+type metricsBuilder struct {
+	md        pmetric.Metrics
+	metadata  map[string]prompb.MetricMetadata
+	resources map[string]*resourceBuilder
+}
+
+// resourceBuilder holds the metric families of a single job and instance
+type resourceBuilder struct {
+	resource pcommon.Resource
+	metrics  pmetric.MetricSlice
+	families map[string]*familyBuilder
+	// order keeps track of the families in the order they were first seen, for a deterministic output
+	order []*familyBuilder
+}
+
+// familyBuilder holds the data points of a metric family, which for histograms and summaries span multiple series
+type familyBuilder struct {
+	metric     pmetric.Metric
+	metricType prompb.MetricMetadata_MetricType
+	points     map[string]*pointBuilder
+	order      []*pointBuilder
+}
+
+// pointBuilder collects the samples of the histogram or summary series sharing the same labels and timestamp
+type pointBuilder struct {
+	labels    []prompb.Label
+	timestamp int64
+	stale     bool
+
+	sum      float64
+	hasSum   bool
+	count    float64
+	hasCount bool
+
+	buckets   []bucket
+	quantiles []quantile
+	exemplars []prompb.Exemplar
+}
+
+type bucket struct {
+	bound float64
+	count float64
+}
+
+type quantile struct {
+	quantile float64
+	value    float64
+}
+
+func newMetricsBuilder(metadata []prompb.MetricMetadata) *metricsBuilder {
+	b := &metricsBuilder{
+		md:        pmetric.NewMetrics(),
+		metadata:  make(map[string]prompb.MetricMetadata, len(metadata)),
+		resources: map[string]*resourceBuilder{},
+	}
+	for _, m := range metadata {
+		b.metadata[m.MetricFamilyName] = m
+	}
+	return b
+}
+
+func (b *metricsBuilder) addTimeSeries(ts prompb.TimeSeries) error {
+	var name, job, instance string
+	labels := make([]prompb.Label, 0, len(ts.Labels))
+	for _, l := range ts.Labels {
+		switch l.Name {
+		case nameStr:
+			name = l.Value
+		case model.JobLabel:
+			job = l.Value
+		case model.InstanceLabel:
+			instance = l.Value
+		default:
+			labels = append(labels, l)
+		}
+	}
+	if name == "" {
+		return errors.New("time series without a metric name")
+	}
+
+	rb := b.resourceFor(job, instance)
+	if name == targetInfoMetricName {
+		for _, l := range labels {
+			rb.resource.Attributes().UpsertString(l.Name, l.Value)
+		}
+		return nil
+	}
+
+	familyName, suffix, metadata := b.familyOf(name)
+	switch metadata.Type {
+	case prompb.MetricMetadata_COUNTER:
+		fb := rb.familyFor(familyName, metadata)
+		for _, sample := range ts.Samples {
+			addNumberDataPoint(fb.metric.Sum().DataPoints(), labels, sample)
+		}
+	case prompb.MetricMetadata_HISTOGRAM:
+		return rb.familyFor(familyName, metadata).addHistogramSeries(name, suffix, labels, ts)
+	case prompb.MetricMetadata_SUMMARY:
+		return rb.familyFor(familyName, metadata).addSummarySeries(name, suffix, labels, ts)
+	default:
+		fb := rb.familyFor(familyName, prompb.MetricMetadata{
+			Type:             prompb.MetricMetadata_GAUGE,
+			MetricFamilyName: metadata.MetricFamilyName,
+			Help:             metadata.Help,
+			Unit:             metadata.Unit,
+		})
+		for _, sample := range ts.Samples {
+			addNumberDataPoint(fb.metric.Gauge().DataPoints(), labels, sample)
+		}
+	}
+	return nil
+}
+
+func (fb *familyBuilder) addHistogramSeries(name string, suffix string, labels []prompb.Label, ts prompb.TimeSeries) error {
+	var bound float64
+	if suffix == bucketStr {
+		var le string
+		labels, le = removeLabel(labels, leStr)
+		if le == "" {
+			return fmt.Errorf("histogram bucket series %q without a %q label", name, leStr)
+		}
+
+		var err error
+		if bound, err = strconv.ParseFloat(le, 64); err != nil {
+			return fmt.Errorf("histogram bucket series %q with an invalid %q label: %w", name, leStr, err)
+		}
+	} else if suffix == "" {
+		return fmt.Errorf("histogram series %q without a %q, %q or %q suffix", name, bucketStr, sumStr, countStr)
+	}
+
+	for _, sample := range ts.Samples {
+		p := fb.pointFor(labels, sample.Timestamp)
+		if value.IsStaleNaN(sample.Value) {
+			p.stale = true
+			continue
+		}
+		switch suffix {
+		case bucketStr:
+			p.buckets = append(p.buckets, bucket{bound: bound, count: sample.Value})
+		case sumStr:
+			p.sum, p.hasSum = sample.Value, true
+		case countStr:
+			p.count, p.hasCount = sample.Value, true
+		}
+	}
+	if suffix == bucketStr && len(ts.Samples) > 0 {
+		// exemplars don't belong to a specific sample, so they are added to the most recent data point
+		p := fb.pointFor(labels, ts.Samples[len(ts.Samples)-1].Timestamp)
+		p.exemplars = append(p.exemplars, ts.Exemplars...)
+	}
+	return nil
+}
+
+func (fb *familyBuilder) addSummarySeries(name string, suffix string, labels []prompb.Label, ts prompb.TimeSeries) error {
+	var q float64
+	if suffix == "" {
+		var quantileLabel string
+		labels, quantileLabel = removeLabel(labels, quantileStr)
+		if quantileLabel == "" {
+			return fmt.Errorf("summary series %q without a %q label", name, quantileStr)
+		}
+
+		var err error
+		if q, err = strconv.ParseFloat(quantileLabel, 64); err != nil {
+			return fmt.Errorf("summary series %q with an invalid %q label: %w", name, quantileStr, err)
+		}
+	} else if suffix == bucketStr {
+		return fmt.Errorf("summary series %q with a %q suffix", name, bucketStr)
+	}
+
+	for _, sample := range ts.Samples {
+		p := fb.pointFor(labels, sample.Timestamp)
+		if value.IsStaleNaN(sample.Value) {
+			p.stale = true
+			continue
+		}
+		switch suffix {
+		case "":
+			p.quantiles = append(p.quantiles, quantile{quantile: q, value: sample.Value})
+		case sumStr:
+			p.sum, p.hasSum = sample.Value, true
+		case countStr:
+			p.count, p.hasCount = sample.Value, true
+		}
+	}
+	return nil
+}
+
+// familyOf returns the name of the metric family the series with the given name belongs to, the suffix of the series
+// name within the family, and the metadata of the family. Series without metadata are their own family.
+func (b *metricsBuilder) familyOf(name string) (string, string, prompb.MetricMetadata) {
+	if metadata, ok := b.metadata[name]; ok {
+		return name, "", metadata
+	}
+	for _, suffix := range []string{bucketStr, sumStr, countStr} {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		familyName := strings.TrimSuffix(name, suffix)
+		metadata, ok := b.metadata[familyName]
+		if ok && (metadata.Type == prompb.MetricMetadata_HISTOGRAM || metadata.Type == prompb.MetricMetadata_SUMMARY) {
+			return familyName, suffix, metadata
+		}
+	}
+	return name, "", prompb.MetricMetadata{MetricFamilyName: name}
+}
+
+// resourceFor returns the builder for the resource identified by the given job and instance, creating it if needed
+func (b *metricsBuilder) resourceFor(job, instance string) *resourceBuilder {
+	key := job + "\x00" + instance
+	if rb, ok := b.resources[key]; ok {
+		return rb
+	}
+
+	rm := b.md.ResourceMetrics().AppendEmpty()
+	attrs := rm.Resource().Attributes()
+	// the job is built from service.namespace and service.name, see createAttributes
+	if job != "" {
+		if idx := strings.Index(job, "/"); idx >= 0 {
+			attrs.InsertString(conventions.AttributeServiceNamespace, job[:idx])
+			attrs.InsertString(conventions.AttributeServiceName, job[idx+1:])
+		} else {
+			attrs.InsertString(conventions.AttributeServiceName, job)
+		}
+	}
+	if instance != "" {
+		attrs.InsertString(conventions.AttributeServiceInstanceID, instance)
+	}
+
+	rb := &resourceBuilder{
+		resource: rm.Resource(),
+		metrics:  rm.ScopeMetrics().AppendEmpty().Metrics(),
+		families: map[string]*familyBuilder{},
+	}
+	b.resources[key] = rb
+	return rb
+}
+
+// build completes the histograms and summaries, and removes the resources that ended up without metrics
+func (b *metricsBuilder) build() error {
+	var errs error
+	for _, rb := range b.resources {
+		for _, fb := range rb.order {
+			errs = multierr.Append(errs, fb.build())
+		}
+		rb.metrics.RemoveIf(func(m pmetric.Metric) bool {
+			return dataPointCount(m) == 0
+		})
+	}
+	b.md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		return rm.ScopeMetrics().At(0).Metrics().Len() == 0
+	})
+	return errs
+}
+
+// familyFor returns the builder for the given metric family, creating the metric if needed
+func (rb *resourceBuilder) familyFor(name string, metadata prompb.MetricMetadata) *familyBuilder {
+	if fb, ok := rb.families[name]; ok {
+		return fb
+	}
+
+	m := rb.metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetDescription(metadata.Help)
+	m.SetUnit(metadata.Unit)
+	switch metadata.Type {
+	case prompb.MetricMetadata_COUNTER:
+		m.SetDataType(pmetric.MetricDataTypeSum)
+		m.Sum().SetIsMonotonic(true)
+		m.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+	case prompb.MetricMetadata_HISTOGRAM:
+		m.SetDataType(pmetric.MetricDataTypeHistogram)
+		m.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+	case prompb.MetricMetadata_SUMMARY:
+		m.SetDataType(pmetric.MetricDataTypeSummary)
+	default:
+		m.SetDataType(pmetric.MetricDataTypeGauge)
+	}
+
+	fb := &familyBuilder{
+		metric:     m,
+		metricType: metadata.Type,
+		points:     map[string]*pointBuilder{},
+	}
+	rb.families[name] = fb
+	rb.order = append(rb.order, fb)
+	return fb
+}
+
+// pointFor returns the data point for the given labels and timestamp, creating it if needed
+func (fb *familyBuilder) pointFor(labels []prompb.Label, ts int64) *pointBuilder {
+	sorted := make([]prompb.Label, len(labels))
+	copy(sorted, labels)
+	sig := timeSeriesSignature(strconv.FormatInt(ts, 10), &sorted)
+	if p, ok := fb.points[sig]; ok {
+		return p
+	}
+
+	p := &pointBuilder{labels: sorted, timestamp: ts}
+	fb.points[sig] = p
+	fb.order = append(fb.order, p)
+	return p
+}
+
+func (fb *familyBuilder) build() error {
+	var errs error
+	switch fb.metricType {
+	case prompb.MetricMetadata_HISTOGRAM:
+		dps := fb.metric.Histogram().DataPoints()
+		for _, p := range fb.order {
+			if err := p.addHistogramDataPoint(dps); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("invalid histogram %q: %w", fb.metric.Name(), err))
+			}
+		}
+	case prompb.MetricMetadata_SUMMARY:
+		dps := fb.metric.Summary().DataPoints()
+		for _, p := range fb.order {
+			p.toSummaryDataPoint(dps.AppendEmpty())
+		}
+	}
+	return errs
+}
+
+func (p *pointBuilder) addHistogramDataPoint(dps pmetric.HistogramDataPointSlice) error {
+	if p.stale {
+		dp := dps.AppendEmpty()
+		fillAttributes(dp.Attributes(), p.labels)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(timestamp.Time(p.timestamp)))
+		dp.SetFlags(pmetric.NewMetricDataPointFlags(pmetric.MetricDataPointFlagNoRecordedValue))
+		return nil
+	}
+
+	sort.Slice(p.buckets, func(i, j int) bool {
+		return p.buckets[i].bound < p.buckets[j].bound
+	})
+
+	// buckets are cumulative in prometheus, but not in OTLP
+	bounds := make([]float64, 0, len(p.buckets))
+	counts := make([]uint64, 0, len(p.buckets)+1)
+	var previous float64
+	for _, b := range p.buckets {
+		if b.count < previous {
+			return fmt.Errorf("the count of bucket %v is lower than the count of the previous bucket", b.bound)
+		}
+		if !math.IsInf(b.bound, 1) {
+			bounds = append(bounds, b.bound)
+		}
+		counts = append(counts, uint64(b.count-previous))
+		previous = b.count
+	}
+	if len(p.buckets) == 0 || !math.IsInf(p.buckets[len(p.buckets)-1].bound, 1) {
+		// the +Inf bucket is missing, so it holds whatever the count doesn't account for
+		var overflow uint64
+		if p.hasCount && p.count > previous {
+			overflow = uint64(p.count - previous)
+		}
+		counts = append(counts, overflow)
+	}
+
+	count := previous
+	if p.hasCount {
+		count = p.count
+	}
+
+	dp := dps.AppendEmpty()
+	fillAttributes(dp.Attributes(), p.labels)
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(timestamp.Time(p.timestamp)))
+	dp.SetCount(uint64(count))
+	if p.hasSum {
+		dp.SetSum(p.sum)
+	}
+	dp.SetExplicitBounds(pcommon.NewImmutableFloat64Slice(bounds))
+	dp.SetBucketCounts(pcommon.NewImmutableUInt64Slice(counts))
+	for _, e := range p.exemplars {
+		appendExemplar(dp.Exemplars(), e)
+	}
+	return nil
+}
+
+func (p *pointBuilder) toSummaryDataPoint(dp pmetric.SummaryDataPoint) {
+	sort.Slice(p.quantiles, func(i, j int) bool {
+		return p.quantiles[i].quantile < p.quantiles[j].quantile
+	})
+
+	fillAttributes(dp.Attributes(), p.labels)
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(timestamp.Time(p.timestamp)))
+	if p.stale {
+		dp.SetFlags(pmetric.NewMetricDataPointFlags(pmetric.MetricDataPointFlagNoRecordedValue))
+		return
+	}
+	dp.SetCount(uint64(p.count))
+	dp.SetSum(p.sum)
+	for _, q := range p.quantiles {
+		qv := dp.QuantileValues().AppendEmpty()
+		qv.SetQuantile(q.quantile)
+		qv.SetValue(q.value)
+	}
+}
+
+func addNumberDataPoint(dps pmetric.NumberDataPointSlice, labels []prompb.Label, sample prompb.Sample) {
+	dp := dps.AppendEmpty()
+	fillAttributes(dp.Attributes(), labels)
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(timestamp.Time(sample.Timestamp)))
+	dp.SetDoubleVal(sample.Value)
+	if value.IsStaleNaN(sample.Value) {
+		dp.SetFlags(pmetric.NewMetricDataPointFlags(pmetric.MetricDataPointFlagNoRecordedValue))
+	}
+}
+
+// appendExemplar converts a prometheus exemplar, restoring the trace and span IDs written by getPromExemplars
+func appendExemplar(exemplars pmetric.ExemplarSlice, e prompb.Exemplar) {
+	exemplar := exemplars.AppendEmpty()
+	exemplar.SetDoubleVal(e.Value)
+	exemplar.SetTimestamp(pcommon.NewTimestampFromTime(timestamp.Time(e.Timestamp)))
+	for _, l := range e.Labels {
+		switch l.Name {
+		case traceIDKey:
+			var traceID [16]byte
+			if decodeID(traceID[:], l.Value) {
+				exemplar.SetTraceID(pcommon.NewTraceID(traceID))
+				continue
+			}
+		case spanIDKey:
+			var spanID [8]byte
+			if decodeID(spanID[:], l.Value) {
+				exemplar.SetSpanID(pcommon.NewSpanID(spanID))
+				continue
+			}
+		}
+		exemplar.FilteredAttributes().UpsertString(l.Name, l.Value)
+	}
+}
+
+// decodeID decodes a hex encoded ID into dst, reporting whether the value encodes exactly len(dst) bytes
+func decodeID(dst []byte, value string) bool {
+	if len(value) != hex.EncodedLen(len(dst)) {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(value))
+	return err == nil
+}
+
+func fillAttributes(attrs pcommon.Map, labels []prompb.Label) {
+	attrs.EnsureCapacity(len(labels))
+	for _, l := range labels {
+		attrs.UpsertString(l.Name, l.Value)
+	}
+}
+
+// removeLabel returns the labels without the one with the given name, along with its value
+func removeLabel(labels []prompb.Label, name string) ([]prompb.Label, string) {
+	for i, l := range labels {
+		if l.Name == name {
+			remaining := make([]prompb.Label, 0, len(labels)-1)
+			remaining = append(remaining, labels[:i]...)
+			return append(remaining, labels[i+1:]...), l.Value
+		}
+	}
+	return labels, ""
+}
+
+func dataPointCount(m pmetric.Metric) int {
+	switch m.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		return m.Gauge().DataPoints().Len()
+	case pmetric.MetricDataTypeSum:
+		return m.Sum().DataPoints().Len()
+	case pmetric.MetricDataTypeHistogram:
+		return m.Histogram().DataPoints().Len()
+	case pmetric.MetricDataTypeSummary:
+		return m.Summary().DataPoints().Len()
+	}
+	return 0
+}
@@ -0,0 +1,361 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+func TestToMetricsResources(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  getPromLabels(nameStr, "temperature", "job", "prod/api", "instance", "host-1:8080", label11, value11),
+				Samples: []prompb.Sample{getSample(floatVal1, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "temperature", "job", "worker", "instance", "host-2:8080"),
+				Samples: []prompb.Sample{getSample(floatVal2, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, targetInfoMetricName, "job", "prod/api", "instance", "host-1:8080", "host_name", "host-1"),
+				Samples: []prompb.Sample{getSample(1, msTime1)},
+			},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	first := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{
+		conventions.AttributeServiceNamespace:  "prod",
+		conventions.AttributeServiceName:       "api",
+		conventions.AttributeServiceInstanceID: "host-1:8080",
+		"host_name":                            "host-1",
+	}, first.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, first.ScopeMetrics().At(0).Metrics().Len())
+
+	gauge := first.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "temperature", gauge.Name())
+	assert.Equal(t, pmetric.MetricDataTypeGauge, gauge.DataType())
+	require.Equal(t, 1, gauge.Gauge().DataPoints().Len())
+	dp := gauge.Gauge().DataPoints().At(0)
+	assert.Equal(t, floatVal1, dp.DoubleVal())
+	assert.Equal(t, map[string]interface{}{label11: value11}, dp.Attributes().AsRaw())
+	assert.Equal(t, msTime1, convertTimeStamp(dp.Timestamp()))
+
+	second := md.ResourceMetrics().At(1)
+	assert.Equal(t, map[string]interface{}{
+		conventions.AttributeServiceName:       "worker",
+		conventions.AttributeServiceInstanceID: "host-2:8080",
+	}, second.Resource().Attributes().AsRaw())
+}
+
+func TestToMetricsCounter(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  getPromLabels(nameStr, "http_requests_total", "code", "200"),
+				Samples: []prompb.Sample{getSample(floatVal1, msTime2), getSample(floatVal2, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "http_requests_total", "code", "500"),
+				Samples: []prompb.Sample{getSample(math.Float64frombits(value.StaleNaN), msTime1)},
+			},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{
+				Type:             prompb.MetricMetadata_COUNTER,
+				MetricFamilyName: "http_requests_total",
+				Help:             "Number of HTTP requests",
+				Unit:             "requests",
+			},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, md.MetricCount())
+
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "http_requests_total", m.Name())
+	assert.Equal(t, "Number of HTTP requests", m.Description())
+	assert.Equal(t, "requests", m.Unit())
+	assert.Equal(t, pmetric.MetricDataTypeSum, m.DataType())
+	assert.True(t, m.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+
+	dps := m.Sum().DataPoints()
+	require.Equal(t, 3, dps.Len())
+	assert.Equal(t, floatVal1, dps.At(0).DoubleVal())
+	assert.Equal(t, floatVal2, dps.At(1).DoubleVal())
+	assert.False(t, dps.At(1).Flags().HasFlag(pmetric.MetricDataPointFlagNoRecordedValue))
+	assert.True(t, dps.At(2).Flags().HasFlag(pmetric.MetricDataPointFlagNoRecordedValue))
+}
+
+func TestToMetricsHistogram(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  getPromLabels(nameStr, "latency_bucket", label11, value11, leStr, "1"),
+				Samples: []prompb.Sample{getSample(2, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "latency_bucket", label11, value11, leStr, pInfStr),
+				Samples: []prompb.Sample{getSample(5, msTime1)},
+				Exemplars: []prompb.Exemplar{
+					{
+						Labels:    getPromLabels(traceIDKey, traceIDValue1, spanIDKey, spanIDValue1, "user", "alice"),
+						Value:     7.5,
+						Timestamp: msTime1,
+					},
+				},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "latency_bucket", label11, value11, leStr, "0.5"),
+				Samples: []prompb.Sample{getSample(1, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "latency_sum", label11, value11),
+				Samples: []prompb.Sample{getSample(12.5, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "latency_count", label11, value11),
+				Samples: []prompb.Sample{getSample(5, msTime1)},
+			},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{
+				Type:             prompb.MetricMetadata_HISTOGRAM,
+				MetricFamilyName: "latency",
+			},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, md.MetricCount())
+
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "latency", m.Name())
+	assert.Equal(t, pmetric.MetricDataTypeHistogram, m.DataType())
+	assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, m.Histogram().AggregationTemporality())
+	require.Equal(t, 1, m.Histogram().DataPoints().Len())
+
+	dp := m.Histogram().DataPoints().At(0)
+	assert.Equal(t, map[string]interface{}{label11: value11}, dp.Attributes().AsRaw())
+	assert.Equal(t, uint64(5), dp.Count())
+	assert.Equal(t, 12.5, dp.Sum())
+	assert.Equal(t, []float64{0.5, 1}, dp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{1, 1, 3}, dp.BucketCounts().AsRaw())
+
+	require.Equal(t, 1, dp.Exemplars().Len())
+	exemplar := dp.Exemplars().At(0)
+	assert.Equal(t, 7.5, exemplar.DoubleVal())
+	assert.Equal(t, traceIDValue1, exemplar.TraceID().HexString())
+	assert.Equal(t, spanIDValue1, exemplar.SpanID().HexString())
+	assert.Equal(t, map[string]interface{}{"user": "alice"}, exemplar.FilteredAttributes().AsRaw())
+}
+
+func TestAppendExemplarInvalidIDs(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{"overlong trace id", traceIDKey, traceIDValue1 + "00"},
+		{"odd-length trace id", traceIDKey, traceIDValue1[1:]},
+		{"non-hex trace id", traceIDKey, "zz" + traceIDValue1[2:]},
+		{"overlong span id", spanIDKey, spanIDValue1 + "00"},
+		{"odd-length span id", spanIDKey, spanIDValue1[1:]},
+		{"non-hex span id", spanIDKey, "zz" + spanIDValue1[2:]},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			exemplars := pmetric.NewExemplarSlice()
+			appendExemplar(exemplars, prompb.Exemplar{
+				Labels:    getPromLabels(tt.key, tt.value),
+				Value:     1,
+				Timestamp: msTime1,
+			})
+
+			require.Equal(t, 1, exemplars.Len())
+			exemplar := exemplars.At(0)
+			assert.True(t, exemplar.TraceID().IsEmpty())
+			assert.True(t, exemplar.SpanID().IsEmpty())
+			assert.Equal(t, map[string]interface{}{tt.key: tt.value}, exemplar.FilteredAttributes().AsRaw())
+		})
+	}
+}
+
+func TestToMetricsSummary(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  getPromLabels(nameStr, "rpc_duration", quantileStr, "0.99"),
+				Samples: []prompb.Sample{getSample(7, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "rpc_duration", quantileStr, "0.5"),
+				Samples: []prompb.Sample{getSample(3, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "rpc_duration_sum"),
+				Samples: []prompb.Sample{getSample(40, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "rpc_duration_count"),
+				Samples: []prompb.Sample{getSample(10, msTime1)},
+			},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{
+				Type:             prompb.MetricMetadata_SUMMARY,
+				MetricFamilyName: "rpc_duration",
+			},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, md.MetricCount())
+
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pmetric.MetricDataTypeSummary, m.DataType())
+	require.Equal(t, 1, m.Summary().DataPoints().Len())
+
+	dp := m.Summary().DataPoints().At(0)
+	assert.Equal(t, uint64(10), dp.Count())
+	assert.Equal(t, 40.0, dp.Sum())
+	require.Equal(t, 2, dp.QuantileValues().Len())
+	assert.Equal(t, 0.5, dp.QuantileValues().At(0).Quantile())
+	assert.Equal(t, 3.0, dp.QuantileValues().At(0).Value())
+	assert.Equal(t, 0.99, dp.QuantileValues().At(1).Quantile())
+	assert.Equal(t, 7.0, dp.QuantileValues().At(1).Value())
+}
+
+func TestToMetricsInvalidSeries(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  getPromLabels(label11, value11),
+				Samples: []prompb.Sample{getSample(floatVal1, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "latency_bucket"),
+				Samples: []prompb.Sample{getSample(floatVal1, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "latency_bucket", leStr, "one"),
+				Samples: []prompb.Sample{getSample(floatVal1, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "queue_size_bucket", leStr, "1"),
+				Samples: []prompb.Sample{getSample(2, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "queue_size_bucket", leStr, "2"),
+				Samples: []prompb.Sample{getSample(1, msTime1)},
+			},
+			{
+				Labels:  getPromLabels(nameStr, "temperature"),
+				Samples: []prompb.Sample{getSample(floatVal1, msTime1)},
+			},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{
+				Type:             prompb.MetricMetadata_HISTOGRAM,
+				MetricFamilyName: "latency",
+			},
+			{
+				Type:             prompb.MetricMetadata_HISTOGRAM,
+				MetricFamilyName: "queue_size",
+			},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "time series without a metric name")
+	assert.Contains(t, err.Error(), `histogram bucket series "latency_bucket" without a "le" label`)
+	assert.Contains(t, err.Error(), `histogram bucket series "latency_bucket" with an invalid "le" label`)
+	assert.Contains(t, err.Error(), `invalid histogram "queue_size"`)
+
+	// the valid series are still translated
+	require.Equal(t, 1, md.MetricCount())
+	assert.Equal(t, "temperature", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestToMetricsRoundTrip(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString(conventions.AttributeServiceName, "api")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetDataType(pmetric.MetricDataTypeHistogram)
+	m.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+	dp := m.Histogram().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(msTime1 * 1e6))
+	dp.SetCount(6)
+	dp.SetSum(21)
+	dp.SetExplicitBounds(pcommon.NewImmutableFloat64Slice([]float64{1, 5}))
+	dp.SetBucketCounts(pcommon.NewImmutableUInt64Slice([]uint64{1, 2, 3}))
+	dp.Attributes().InsertString(label11, value11)
+
+	tsMap, err := FromMetrics(md, Settings{})
+	require.NoError(t, err)
+	req := &prompb.WriteRequest{
+		Metadata: []prompb.MetricMetadata{{Type: prompb.MetricMetadata_HISTOGRAM, MetricFamilyName: "latency"}},
+	}
+	for _, ts := range tsMap {
+		// the target info metric written by FromMetrics isn't named target_info, and would be translated to a gauge
+		if getLabelValue(ts.Labels, nameStr) == targetMetricName {
+			continue
+		}
+		req.Timeseries = append(req.Timeseries, *ts)
+	}
+
+	got, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, got.MetricCount())
+	assert.Equal(t, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw(), got.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+
+	gotDp := got.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	assert.Equal(t, dp.Timestamp(), gotDp.Timestamp())
+	assert.Equal(t, dp.Count(), gotDp.Count())
+	assert.Equal(t, dp.Sum(), gotDp.Sum())
+	assert.Equal(t, dp.ExplicitBounds().AsRaw(), gotDp.ExplicitBounds().AsRaw())
+	assert.Equal(t, dp.BucketCounts().AsRaw(), gotDp.BucketCounts().AsRaw())
+	assert.Equal(t, dp.Attributes().AsRaw(), gotDp.Attributes().AsRaw())
+}
+
+func getLabelValue(labels []prompb.Label, name string) string {
+	for _, l := range labels {
+		if l.Name == name {
+			return l.Value
+		}
+	}
+	return ""
+}
@@ -82,5 +82,7 @@ func (mi *MetricIdentity) IsFloatVal() bool {
 }
 
 func (mi *MetricIdentity) IsSupportedMetricType() bool {
-	return mi.MetricDataType == pmetric.MetricDataTypeSum
+	return mi.MetricDataType == pmetric.MetricDataTypeSum ||
+		mi.MetricDataType == pmetric.MetricDataTypeHistogram ||
+		mi.MetricDataType == pmetric.MetricDataTypeExponentialHistogram
 }
@@ -41,9 +41,11 @@ type State struct {
 }
 
 type DeltaValue struct {
-	StartTimestamp pcommon.Timestamp
-	FloatValue     float64
-	IntValue       int64
+	StartTimestamp    pcommon.Timestamp
+	FloatValue        float64
+	IntValue          int64
+	HistogramValue    *HistogramPoint
+	ExpHistogramValue *ExponentialHistogramPoint
 }
 
 func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration) *MetricTracker {
@@ -92,9 +94,11 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	if !ok {
 		if metricID.MetricIsMonotonic {
 			out = DeltaValue{
-				StartTimestamp: metricPoint.ObservedTimestamp,
-				FloatValue:     metricPoint.FloatValue,
-				IntValue:       metricPoint.IntValue,
+				StartTimestamp:    metricPoint.ObservedTimestamp,
+				FloatValue:        metricPoint.FloatValue,
+				IntValue:          metricPoint.IntValue,
+				HistogramValue:    metricPoint.HistogramValue,
+				ExpHistogramValue: metricPoint.ExpHistogramValue,
 			}
 			valid = true
 		}
@@ -108,7 +112,12 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 
 	out.StartTimestamp = state.PrevPoint.ObservedTimestamp
 
-	if metricID.IsFloatVal() {
+	switch {
+	case metricPoint.HistogramValue != nil:
+		out.HistogramValue = metricPoint.HistogramValue.delta(state.PrevPoint.HistogramValue)
+	case metricPoint.ExpHistogramValue != nil:
+		out.ExpHistogramValue = metricPoint.ExpHistogramValue.delta(state.PrevPoint.ExpHistogramValue)
+	case metricID.IsFloatVal():
 		value := metricPoint.FloatValue
 		prevValue := state.PrevPoint.FloatValue
 		delta := value - prevValue
@@ -119,7 +128,7 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 		}
 
 		out.FloatValue = delta
-	} else {
+	default:
 		value := metricPoint.IntValue
 		prevValue := state.PrevPoint.IntValue
 		delta := value - prevValue
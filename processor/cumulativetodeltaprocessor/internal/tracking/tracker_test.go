@@ -151,6 +151,145 @@ func TestMetricTracker_Convert(t *testing.T) {
 	})
 }
 
+func TestMetricTracker_ConvertHistogram(t *testing.T) {
+	miHistogram := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricDataType:         pmetric.MetricDataTypeHistogram,
+		MetricIsMonotonic:      true,
+		Attributes:             pcommon.NewMap(),
+	}
+
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0)
+
+	tests := []struct {
+		name    string
+		value   HistogramPoint
+		wantOut HistogramPoint
+	}{
+		{
+			name:    "Initial Value recorded",
+			value:   HistogramPoint{Count: 10, Sum: 100, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{5, 3, 2}},
+			wantOut: HistogramPoint{Count: 10, Sum: 100, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{5, 3, 2}},
+		},
+		{
+			name:    "Higher Value Recorded",
+			value:   HistogramPoint{Count: 25, Sum: 250, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{10, 10, 5}},
+			wantOut: HistogramPoint{Count: 15, Sum: 150, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{5, 7, 3}},
+		},
+		{
+			name:    "Lower Count Recorded",
+			value:   HistogramPoint{Count: 5, Sum: 50, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{2, 2, 1}},
+			wantOut: HistogramPoint{Count: 5, Sum: 50, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{2, 2, 1}},
+		},
+		{
+			name:    "Lower Bucket Recorded",
+			value:   HistogramPoint{Count: 10, Sum: 100, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{1, 5, 4}},
+			wantOut: HistogramPoint{Count: 10, Sum: 100, ExplicitBounds: []float64{1, 10}, Buckets: []uint64{1, 5, 4}},
+		},
+		{
+			name:    "Bounds Changed",
+			value:   HistogramPoint{Count: 20, Sum: 200, ExplicitBounds: []float64{1, 5}, Buckets: []uint64{5, 10, 5}},
+			wantOut: HistogramPoint{Count: 20, Sum: 200, ExplicitBounds: []float64{1, 5}, Buckets: []uint64{5, 10, 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := tt.value
+			gotOut, valid := m.Convert(MetricPoint{
+				Identity: miHistogram,
+				Value:    ValuePoint{HistogramValue: &value},
+			})
+			if !valid || !reflect.DeepEqual(*gotOut.HistogramValue, tt.wantOut) {
+				t.Errorf("MetricTracker.Convert(MetricDataTypeHistogram) = %v, want %v", gotOut.HistogramValue, tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestMetricTracker_ConvertExponentialHistogram(t *testing.T) {
+	miHistogram := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricDataType:         pmetric.MetricDataTypeExponentialHistogram,
+		MetricIsMonotonic:      true,
+		Attributes:             pcommon.NewMap(),
+	}
+
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0)
+
+	tests := []struct {
+		name    string
+		value   ExponentialHistogramPoint
+		wantOut ExponentialHistogramPoint
+	}{
+		{
+			name: "Initial Value recorded",
+			value: ExponentialHistogramPoint{
+				Count: 10, Sum: 100, Scale: 2, ZeroCount: 1,
+				Positive: ExponentialBuckets{Offset: 2, BucketCounts: []uint64{4, 3}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{2}},
+			},
+			wantOut: ExponentialHistogramPoint{
+				Count: 10, Sum: 100, Scale: 2, ZeroCount: 1,
+				Positive: ExponentialBuckets{Offset: 2, BucketCounts: []uint64{4, 3}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{2}},
+			},
+		},
+		{
+			name: "Buckets Grew Downwards",
+			value: ExponentialHistogramPoint{
+				Count: 20, Sum: 150, Scale: 2, ZeroCount: 2,
+				Positive: ExponentialBuckets{Offset: 1, BucketCounts: []uint64{5, 6, 4}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{3}},
+			},
+			wantOut: ExponentialHistogramPoint{
+				Count: 10, Sum: 50, Scale: 2, ZeroCount: 1,
+				Positive: ExponentialBuckets{Offset: 1, BucketCounts: []uint64{5, 2, 1}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{1}},
+			},
+		},
+		{
+			name: "Scale Changed",
+			value: ExponentialHistogramPoint{
+				Count: 25, Sum: 200, Scale: 1, ZeroCount: 2,
+				Positive: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{10, 10}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{3}},
+			},
+			wantOut: ExponentialHistogramPoint{
+				Count: 25, Sum: 200, Scale: 1, ZeroCount: 2,
+				Positive: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{10, 10}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{3}},
+			},
+		},
+		{
+			name: "Bucket Disappeared",
+			value: ExponentialHistogramPoint{
+				Count: 30, Sum: 250, Scale: 1, ZeroCount: 2,
+				Positive: ExponentialBuckets{Offset: 1, BucketCounts: []uint64{25}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{3}},
+			},
+			wantOut: ExponentialHistogramPoint{
+				Count: 30, Sum: 250, Scale: 1, ZeroCount: 2,
+				Positive: ExponentialBuckets{Offset: 1, BucketCounts: []uint64{25}},
+				Negative: ExponentialBuckets{Offset: 0, BucketCounts: []uint64{3}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := tt.value
+			gotOut, valid := m.Convert(MetricPoint{
+				Identity: miHistogram,
+				Value:    ValuePoint{ExpHistogramValue: &value},
+			})
+			if !valid || !reflect.DeepEqual(*gotOut.ExpHistogramValue, tt.wantOut) {
+				t.Errorf("MetricTracker.Convert(MetricDataTypeExponentialHistogram) = %v, want %v", gotOut.ExpHistogramValue, tt.wantOut)
+			}
+		})
+	}
+}
+
 func Test_metricTracker_removeStale(t *testing.T) {
 	currentTime := pcommon.Timestamp(100)
 	freshPoint := ValuePoint{
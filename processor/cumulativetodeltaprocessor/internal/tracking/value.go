@@ -20,4 +20,114 @@ type ValuePoint struct {
 	ObservedTimestamp pcommon.Timestamp
 	FloatValue        float64
 	IntValue          int64
+	HistogramValue    *HistogramPoint
+	ExpHistogramValue *ExponentialHistogramPoint
+}
+
+// HistogramPoint holds the values of a histogram data point with explicit bounds.
+type HistogramPoint struct {
+	Count          uint64
+	Sum            float64
+	ExplicitBounds []float64
+	Buckets        []uint64
+}
+
+// delta calculates the difference between this point and the previous one. When the bucket layout changed
+// or any of the counts decreased, the histogram was reset and this point is returned as it is.
+func (h *HistogramPoint) delta(prev *HistogramPoint) *HistogramPoint {
+	if h.Count < prev.Count || len(h.Buckets) != len(prev.Buckets) || !equalBounds(h.ExplicitBounds, prev.ExplicitBounds) {
+		return h
+	}
+
+	out := &HistogramPoint{
+		Count:          h.Count - prev.Count,
+		Sum:            h.Sum - prev.Sum,
+		ExplicitBounds: h.ExplicitBounds,
+		Buckets:        make([]uint64, len(h.Buckets)),
+	}
+	for i, count := range h.Buckets {
+		if count < prev.Buckets[i] {
+			return h
+		}
+		out.Buckets[i] = count - prev.Buckets[i]
+	}
+	return out
+}
+
+// ExponentialHistogramPoint holds the values of an exponential histogram data point.
+type ExponentialHistogramPoint struct {
+	Count     uint64
+	Sum       float64
+	Scale     int32
+	ZeroCount uint64
+	Positive  ExponentialBuckets
+	Negative  ExponentialBuckets
+}
+
+// ExponentialBuckets holds one of the bucket ranges of an exponential histogram data point.
+type ExponentialBuckets struct {
+	Offset       int32
+	BucketCounts []uint64
+}
+
+// delta calculates the difference between this point and the previous one. When the scale changed
+// or any of the counts decreased, the histogram was reset and this point is returned as it is.
+func (h *ExponentialHistogramPoint) delta(prev *ExponentialHistogramPoint) *ExponentialHistogramPoint {
+	if h.Scale != prev.Scale || h.Count < prev.Count || h.ZeroCount < prev.ZeroCount {
+		return h
+	}
+
+	positive, ok := h.Positive.delta(prev.Positive)
+	if !ok {
+		return h
+	}
+	negative, ok := h.Negative.delta(prev.Negative)
+	if !ok {
+		return h
+	}
+
+	return &ExponentialHistogramPoint{
+		Count:     h.Count - prev.Count,
+		Sum:       h.Sum - prev.Sum,
+		Scale:     h.Scale,
+		ZeroCount: h.ZeroCount - prev.ZeroCount,
+		Positive:  positive,
+		Negative:  negative,
+	}
+}
+
+// delta subtracts the previous buckets from these ones, aligning them by their offsets. The returned
+// flag is false when the previous buckets can't be subtracted, meaning that the histogram was reset.
+func (b ExponentialBuckets) delta(prev ExponentialBuckets) (ExponentialBuckets, bool) {
+	out := ExponentialBuckets{
+		Offset:       b.Offset,
+		BucketCounts: make([]uint64, len(b.BucketCounts)),
+	}
+	copy(out.BucketCounts, b.BucketCounts)
+
+	shift := int(prev.Offset) - int(b.Offset)
+	for i, count := range prev.BucketCounts {
+		if count == 0 {
+			continue
+		}
+
+		idx := i + shift
+		if idx < 0 || idx >= len(out.BucketCounts) || out.BucketCounts[idx] < count {
+			return b, false
+		}
+		out.BucketCounts[idx] -= count
+	}
+	return out, true
+}
+
+func equalBounds(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
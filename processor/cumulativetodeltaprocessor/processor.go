@@ -18,6 +18,7 @@ import (
 	"context"
 	"math"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
@@ -91,6 +92,41 @@ func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pme
 					ctdp.convertDataPoints(ms.DataPoints(), baseIdentity)
 					ms.SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
 					return ms.DataPoints().Len() == 0
+				case pmetric.MetricDataTypeHistogram:
+					ms := m.Histogram()
+					if ms.AggregationTemporality() != pmetric.MetricAggregationTemporalityCumulative {
+						return false
+					}
+
+					// The counts of a histogram are always monotonic
+					baseIdentity := tracking.MetricIdentity{
+						Resource:               rm.Resource(),
+						InstrumentationLibrary: ilm.Scope(),
+						MetricDataType:         m.DataType(),
+						MetricName:             m.Name(),
+						MetricUnit:             m.Unit(),
+						MetricIsMonotonic:      true,
+					}
+					ctdp.convertHistogramDataPoints(ms.DataPoints(), baseIdentity)
+					ms.SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
+					return ms.DataPoints().Len() == 0
+				case pmetric.MetricDataTypeExponentialHistogram:
+					ms := m.ExponentialHistogram()
+					if ms.AggregationTemporality() != pmetric.MetricAggregationTemporalityCumulative {
+						return false
+					}
+
+					baseIdentity := tracking.MetricIdentity{
+						Resource:               rm.Resource(),
+						InstrumentationLibrary: ilm.Scope(),
+						MetricDataType:         m.DataType(),
+						MetricName:             m.Name(),
+						MetricUnit:             m.Unit(),
+						MetricIsMonotonic:      true,
+					}
+					ctdp.convertExponentialHistogramDataPoints(ms.DataPoints(), baseIdentity)
+					ms.SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
+					return ms.DataPoints().Len() == 0
 				default:
 					return false
 				}
@@ -159,3 +195,89 @@ func (ctdp *cumulativeToDeltaProcessor) convertDataPoints(in interface{}, baseId
 		})
 	}
 }
+
+func (ctdp *cumulativeToDeltaProcessor) convertHistogramDataPoints(dps pmetric.HistogramDataPointSlice, baseIdentity tracking.MetricIdentity) {
+	dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+		// Do not attempt to transform data points without values
+		if dp.Flags().HasFlag(pmetric.MetricDataPointFlagNoRecordedValue) {
+			return false
+		}
+
+		id := baseIdentity
+		id.StartTimestamp = dp.StartTimestamp()
+		id.Attributes = dp.Attributes()
+		trackingPoint := tracking.MetricPoint{
+			Identity: id,
+			Value: tracking.ValuePoint{
+				ObservedTimestamp: dp.Timestamp(),
+				HistogramValue: &tracking.HistogramPoint{
+					Count:          dp.Count(),
+					Sum:            dp.Sum(),
+					ExplicitBounds: dp.ExplicitBounds().AsRaw(),
+					Buckets:        dp.BucketCounts().AsRaw(),
+				},
+			},
+		}
+		delta, valid := ctdp.deltaCalculator.Convert(trackingPoint)
+		if !valid {
+			return true
+		}
+
+		dp.SetStartTimestamp(delta.StartTimestamp)
+		dp.SetCount(delta.HistogramValue.Count)
+		if dp.HasSum() {
+			dp.SetSum(delta.HistogramValue.Sum)
+		}
+		dp.SetBucketCounts(pcommon.NewImmutableUInt64Slice(delta.HistogramValue.Buckets))
+		return false
+	})
+}
+
+func (ctdp *cumulativeToDeltaProcessor) convertExponentialHistogramDataPoints(dps pmetric.ExponentialHistogramDataPointSlice, baseIdentity tracking.MetricIdentity) {
+	dps.RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+		// Do not attempt to transform data points without values
+		if dp.Flags().HasFlag(pmetric.MetricDataPointFlagNoRecordedValue) {
+			return false
+		}
+
+		id := baseIdentity
+		id.StartTimestamp = dp.StartTimestamp()
+		id.Attributes = dp.Attributes()
+		trackingPoint := tracking.MetricPoint{
+			Identity: id,
+			Value: tracking.ValuePoint{
+				ObservedTimestamp: dp.Timestamp(),
+				ExpHistogramValue: &tracking.ExponentialHistogramPoint{
+					Count:     dp.Count(),
+					Sum:       dp.Sum(),
+					Scale:     dp.Scale(),
+					ZeroCount: dp.ZeroCount(),
+					Positive: tracking.ExponentialBuckets{
+						Offset:       dp.Positive().Offset(),
+						BucketCounts: dp.Positive().BucketCounts().AsRaw(),
+					},
+					Negative: tracking.ExponentialBuckets{
+						Offset:       dp.Negative().Offset(),
+						BucketCounts: dp.Negative().BucketCounts().AsRaw(),
+					},
+				},
+			},
+		}
+		delta, valid := ctdp.deltaCalculator.Convert(trackingPoint)
+		if !valid {
+			return true
+		}
+
+		dp.SetStartTimestamp(delta.StartTimestamp)
+		dp.SetCount(delta.ExpHistogramValue.Count)
+		if dp.HasSum() {
+			dp.SetSum(delta.ExpHistogramValue.Sum)
+		}
+		dp.SetZeroCount(delta.ExpHistogramValue.ZeroCount)
+		dp.Positive().SetOffset(delta.ExpHistogramValue.Positive.Offset)
+		dp.Positive().SetBucketCounts(pcommon.NewImmutableUInt64Slice(delta.ExpHistogramValue.Positive.BucketCounts))
+		dp.Negative().SetOffset(delta.ExpHistogramValue.Negative.Offset)
+		dp.Negative().SetBucketCounts(pcommon.NewImmutableUInt64Slice(delta.ExpHistogramValue.Negative.BucketCounts))
+		return false
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/service/servicetest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+func TestLoadingFullConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
+	assert.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	expCfg := &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Include: MatchMetrics{
+			Metrics: []string{
+				"metric1",
+				"metric2",
+			},
+			Config: filterset.Config{
+				MatchType: "strict",
+			},
+		},
+		Exclude: MatchMetrics{
+			Metrics: []string{
+				"metric3",
+			},
+			Config: filterset.Config{
+				MatchType: "strict",
+			},
+		},
+		MaxStaleness: 10 * time.Minute,
+		MaxStreams:   1000,
+		StartTime:    startTimeProcessorStart,
+	}
+	assert.Equal(t, expCfg, cfg.Processors[expCfg.ID()])
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		configName   string
+		succeed      bool
+		errorMessage string
+	}{
+		{
+			configName: "config.yaml",
+			succeed:    true,
+		},
+		{
+			configName: "config_empty.yaml",
+			succeed:    true,
+		},
+		{
+			configName:   "config_invalid_start_time.yaml",
+			succeed:      false,
+			errorMessage: `start_time must be either "first_point" or "processor_start", got "last_point"`,
+		},
+		{
+			configName:   "config_negative_max_streams.yaml",
+			succeed:      false,
+			errorMessage: "max_streams must not be negative",
+		},
+		{
+			configName:   "config_missing_match_type.yaml",
+			succeed:      false,
+			errorMessage: "match_type must be set if metrics are supplied",
+		},
+	}
+
+	for _, test := range tests {
+		factories, err := componenttest.NopFactories()
+		assert.NoError(t, err)
+
+		factory := NewFactory()
+		factories.Processors[typeStr] = factory
+		t.Run(test.configName, func(t *testing.T) {
+			config, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", test.configName), factories)
+			if test.succeed {
+				assert.NotNil(t, config)
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, fmt.Sprintf("processor %q has invalid configuration: %s", typeStr, test.errorMessage))
+			}
+		})
+	}
+}
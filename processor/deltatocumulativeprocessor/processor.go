@@ -0,0 +1,270 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+type deltaToCumulativeProcessor struct {
+	includeFS    filterset.FilterSet
+	excludeFS    filterset.FilterSet
+	logger       *zap.Logger
+	maxStaleness time.Duration
+	maxStreams   int
+	startTime    string
+
+	processorStart pcommon.Timestamp
+	streams        map[string]*stream
+	lock           sync.Mutex
+	cancelFunc     context.CancelFunc
+}
+
+func newDeltaToCumulativeProcessor(config *Config, logger *zap.Logger) *deltaToCumulativeProcessor {
+	p := &deltaToCumulativeProcessor{
+		logger:       logger,
+		maxStaleness: config.MaxStaleness,
+		maxStreams:   config.MaxStreams,
+		startTime:    config.StartTime,
+		streams:      map[string]*stream{},
+	}
+	if len(config.Include.Metrics) > 0 {
+		p.includeFS, _ = filterset.CreateFilterSet(config.Include.Metrics, &config.Include.Config)
+	}
+	if len(config.Exclude.Metrics) > 0 {
+		p.excludeFS, _ = filterset.CreateFilterSet(config.Exclude.Metrics, &config.Exclude.Config)
+	}
+	return p
+}
+
+func (dtcp *deltaToCumulativeProcessor) start(context.Context, component.Host) error {
+	dtcp.processorStart = pcommon.NewTimestampFromTime(time.Now())
+
+	if dtcp.maxStaleness > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		dtcp.cancelFunc = cancel
+		go dtcp.sweeper(ctx)
+	}
+	return nil
+}
+
+func (dtcp *deltaToCumulativeProcessor) shutdown(context.Context) error {
+	if dtcp.cancelFunc != nil {
+		dtcp.cancelFunc()
+	}
+	return nil
+}
+
+// processMetrics implements the ProcessMetricsFunc type.
+func (dtcp *deltaToCumulativeProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	dtcp.lock.Lock()
+	defer dtcp.lock.Unlock()
+
+	now := time.Now()
+	resourceMetricsSlice := md.ResourceMetrics()
+	resourceMetricsSlice.RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		ilms := rm.ScopeMetrics()
+		ilms.RemoveIf(func(ilm pmetric.ScopeMetrics) bool {
+			ms := ilm.Metrics()
+			ms.RemoveIf(func(m pmetric.Metric) bool {
+				if !dtcp.shouldConvertMetric(m.Name()) {
+					return false
+				}
+				switch m.DataType() {
+				case pmetric.MetricDataTypeSum:
+					ms := m.Sum()
+					if ms.AggregationTemporality() != pmetric.MetricAggregationTemporalityDelta {
+						return false
+					}
+
+					baseIdentity := metricIdentity(rm.Resource(), ilm.Scope(), m)
+					dtcp.accumulateNumberDataPoints(ms.DataPoints(), baseIdentity, now)
+					ms.SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+					return ms.DataPoints().Len() == 0
+				case pmetric.MetricDataTypeHistogram:
+					ms := m.Histogram()
+					if ms.AggregationTemporality() != pmetric.MetricAggregationTemporalityDelta {
+						return false
+					}
+
+					baseIdentity := metricIdentity(rm.Resource(), ilm.Scope(), m)
+					dtcp.accumulateHistogramDataPoints(ms.DataPoints(), baseIdentity, now)
+					ms.SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+					return ms.DataPoints().Len() == 0
+				default:
+					return false
+				}
+			})
+			return ilm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+	return md, nil
+}
+
+func (dtcp *deltaToCumulativeProcessor) shouldConvertMetric(metricName string) bool {
+	return (dtcp.includeFS == nil || dtcp.includeFS.Matches(metricName)) &&
+		(dtcp.excludeFS == nil || !dtcp.excludeFS.Matches(metricName))
+}
+
+func (dtcp *deltaToCumulativeProcessor) accumulateNumberDataPoints(dps pmetric.NumberDataPointSlice, baseIdentity string, now time.Time) {
+	dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+		// Do not attempt to accumulate NaN values, as they signal stale metrics
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble && math.IsNaN(dp.DoubleVal()) {
+			return false
+		}
+
+		id := streamIdentity(baseIdentity, dp.ValueType().String(), dp.Attributes())
+		s, keep := dtcp.streamFor(id, dp.StartTimestamp(), dp.Timestamp(), now)
+		if !keep {
+			return true
+		}
+
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble {
+			s.doubleValue += dp.DoubleVal()
+			dp.SetDoubleVal(s.doubleValue)
+		} else {
+			s.intValue += dp.IntVal()
+			dp.SetIntVal(s.intValue)
+		}
+		dp.SetStartTimestamp(s.start)
+		return false
+	})
+}
+
+func (dtcp *deltaToCumulativeProcessor) accumulateHistogramDataPoints(dps pmetric.HistogramDataPointSlice, baseIdentity string, now time.Time) {
+	dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+		// Do not attempt to accumulate data points without values
+		if dp.Flags().HasFlag(pmetric.MetricDataPointFlagNoRecordedValue) {
+			return false
+		}
+
+		id := streamIdentity(baseIdentity, "", dp.Attributes())
+		s, keep := dtcp.streamFor(id, dp.StartTimestamp(), dp.Timestamp(), now)
+		if !keep {
+			return true
+		}
+
+		bounds := dp.ExplicitBounds().AsRaw()
+		buckets := dp.BucketCounts().AsRaw()
+		if s.histogram == nil || !s.histogram.compatible(bounds, buckets) {
+			// the bucket layout changed, so the previous values can't be added up anymore
+			if s.histogram != nil {
+				s.start = dtcp.seriesStart(dp.StartTimestamp())
+			}
+			s.histogram = &histogramValue{
+				bounds:  bounds,
+				buckets: make([]uint64, len(buckets)),
+			}
+		}
+
+		h := s.histogram
+		h.count += dp.Count()
+		h.sum += dp.Sum()
+		for i, count := range buckets {
+			h.buckets[i] += count
+		}
+		if dp.HasMin() && (!h.hasMin || dp.Min() < h.min) {
+			h.min, h.hasMin = dp.Min(), true
+		}
+		if dp.HasMax() && (!h.hasMax || dp.Max() > h.max) {
+			h.max, h.hasMax = dp.Max(), true
+		}
+
+		dp.SetStartTimestamp(s.start)
+		dp.SetCount(h.count)
+		if dp.HasSum() {
+			dp.SetSum(h.sum)
+		}
+		buckets = make([]uint64, len(h.buckets))
+		copy(buckets, h.buckets)
+		dp.SetBucketCounts(pcommon.NewImmutableUInt64Slice(buckets))
+		if h.hasMin {
+			dp.SetMin(h.min)
+		}
+		if h.hasMax {
+			dp.SetMax(h.max)
+		}
+		return false
+	})
+}
+
+// streamFor returns the state for the stream with the given identity, creating it if needed. The returned flag is false
+// when the data point should be dropped, either because the maximum number of streams was reached, or because the
+// data point overlaps with the deltas that were already accumulated.
+func (dtcp *deltaToCumulativeProcessor) streamFor(id string, start, ts pcommon.Timestamp, now time.Time) (*stream, bool) {
+	s, ok := dtcp.streams[id]
+	if !ok {
+		if dtcp.maxStreams > 0 && len(dtcp.streams) >= dtcp.maxStreams {
+			dtcp.logger.Debug("dropping data point for new stream, as the maximum number of streams was reached",
+				zap.Int("max_streams", dtcp.maxStreams))
+			return nil, false
+		}
+
+		s = &stream{start: dtcp.seriesStart(start)}
+		dtcp.streams[id] = s
+	} else if ts <= s.last || (start != 0 && start < s.last) {
+		dtcp.logger.Debug("dropping out of order data point, as it overlaps with the accumulated deltas",
+			zap.Uint64("start", uint64(start)), zap.Uint64("timestamp", uint64(ts)), zap.Uint64("last", uint64(s.last)))
+		return nil, false
+	}
+
+	s.last = ts
+	s.lastSeen = now
+	return s, true
+}
+
+// seriesStart returns the start timestamp for a new cumulative series, given the start timestamp of its first delta
+func (dtcp *deltaToCumulativeProcessor) seriesStart(start pcommon.Timestamp) pcommon.Timestamp {
+	if dtcp.startTime == startTimeProcessorStart || start == 0 {
+		return dtcp.processorStart
+	}
+	return start
+}
+
+func (dtcp *deltaToCumulativeProcessor) removeStale(staleBefore time.Time) {
+	dtcp.lock.Lock()
+	defer dtcp.lock.Unlock()
+
+	for id, s := range dtcp.streams {
+		if s.lastSeen.Before(staleBefore) {
+			dtcp.logger.Debug("removing stale stream", zap.String("stream", id))
+			delete(dtcp.streams, id)
+		}
+	}
+}
+
+func (dtcp *deltaToCumulativeProcessor) sweeper(ctx context.Context) {
+	ticker := time.NewTicker(dtcp.maxStaleness)
+	for {
+		select {
+		case currentTime := <-ticker.C:
+			dtcp.removeStale(currentTime.Add(-dtcp.maxStaleness))
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		}
+	}
+}
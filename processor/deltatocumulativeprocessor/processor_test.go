@@ -0,0 +1,337 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+func TestAccumulateSums(t *testing.T) {
+	// prepare
+	p := newTestProcessor(t, func(cfg *Config) {})
+
+	for _, tt := range []struct {
+		name      string
+		in        pmetric.Metrics
+		wantStart pcommon.Timestamp
+		wantValue int64
+	}{
+		{"first delta", deltaSum("requests", "a", 10, 20, 5), 10, 5},
+		{"second delta", deltaSum("requests", "a", 20, 30, 3), 10, 8},
+		{"other stream", deltaSum("requests", "b", 25, 30, 1), 25, 1},
+		{"gap between deltas", deltaSum("requests", "a", 40, 50, 2), 10, 10},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// test
+			out, err := p.processMetrics(context.Background(), tt.in)
+
+			// verify
+			require.NoError(t, err)
+			sum := out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum()
+			assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, sum.AggregationTemporality())
+			require.Equal(t, 1, sum.DataPoints().Len())
+			assert.Equal(t, tt.wantStart, sum.DataPoints().At(0).StartTimestamp())
+			assert.Equal(t, tt.wantValue, sum.DataPoints().At(0).IntVal())
+		})
+	}
+}
+
+func TestAccumulateDoubleSums(t *testing.T) {
+	// prepare
+	p := newTestProcessor(t, func(cfg *Config) {})
+	first := deltaSum("requests", "a", 10, 20, 0)
+	first.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).SetDoubleVal(1.5)
+	second := deltaSum("requests", "a", 20, 30, 0)
+	second.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).SetDoubleVal(2.25)
+
+	// test
+	_, err := p.processMetrics(context.Background(), first)
+	require.NoError(t, err)
+	out, err := p.processMetrics(context.Background(), second)
+
+	// verify
+	require.NoError(t, err)
+	dp := out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, 3.75, dp.DoubleVal())
+	assert.Equal(t, pcommon.Timestamp(10), dp.StartTimestamp())
+}
+
+func TestDropOutOfOrderDeltas(t *testing.T) {
+	// prepare
+	p := newTestProcessor(t, func(cfg *Config) {})
+	_, err := p.processMetrics(context.Background(), deltaSum("requests", "a", 20, 30, 5))
+	require.NoError(t, err)
+
+	// test
+	out, err := p.processMetrics(context.Background(), deltaSum("requests", "a", 10, 20, 3))
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, 0, out.ResourceMetrics().Len())
+
+	// the state wasn't changed by the dropped data point
+	out, err = p.processMetrics(context.Background(), deltaSum("requests", "a", 30, 40, 1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntVal())
+}
+
+func TestMaxStreams(t *testing.T) {
+	// prepare
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.MaxStreams = 1
+	})
+	_, err := p.processMetrics(context.Background(), deltaSum("requests", "a", 10, 20, 5))
+	require.NoError(t, err)
+
+	// test
+	out, err := p.processMetrics(context.Background(), deltaSum("requests", "b", 10, 20, 5))
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, 0, out.ResourceMetrics().Len())
+	assert.Len(t, p.streams, 1)
+
+	// the existing stream is still accumulated
+	out, err = p.processMetrics(context.Background(), deltaSum("requests", "a", 20, 30, 5))
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntVal())
+}
+
+func TestRemoveStaleStreams(t *testing.T) {
+	// prepare
+	p := newTestProcessor(t, func(cfg *Config) {})
+	_, err := p.processMetrics(context.Background(), deltaSum("requests", "a", 10, 20, 5))
+	require.NoError(t, err)
+
+	// test
+	p.removeStale(time.Now().Add(time.Minute))
+
+	// verify
+	assert.Len(t, p.streams, 0)
+
+	// the next delta starts a new cumulative series
+	out, err := p.processMetrics(context.Background(), deltaSum("requests", "a", 20, 30, 3))
+	require.NoError(t, err)
+	dp := out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(3), dp.IntVal())
+	assert.Equal(t, pcommon.Timestamp(20), dp.StartTimestamp())
+}
+
+func TestStartTime(t *testing.T) {
+	for _, tt := range []struct {
+		desc      string
+		startTime string
+		in        pmetric.Metrics
+		wantStart func(p *deltaToCumulativeProcessor) pcommon.Timestamp
+	}{
+		{
+			desc:      "first point",
+			startTime: startTimeFirstPoint,
+			in:        deltaSum("requests", "a", 10, 20, 5),
+			wantStart: func(*deltaToCumulativeProcessor) pcommon.Timestamp { return 10 },
+		},
+		{
+			desc:      "first point without start timestamp",
+			startTime: startTimeFirstPoint,
+			in:        deltaSum("requests", "a", 0, 20, 5),
+			wantStart: func(p *deltaToCumulativeProcessor) pcommon.Timestamp { return p.processorStart },
+		},
+		{
+			desc:      "processor start",
+			startTime: startTimeProcessorStart,
+			in:        deltaSum("requests", "a", 10, 20, 5),
+			wantStart: func(p *deltaToCumulativeProcessor) pcommon.Timestamp { return p.processorStart },
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// prepare
+			p := newTestProcessor(t, func(cfg *Config) {
+				cfg.StartTime = tt.startTime
+			})
+
+			// test
+			out, err := p.processMetrics(context.Background(), tt.in)
+
+			// verify
+			require.NoError(t, err)
+			dp := out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+			assert.Equal(t, tt.wantStart(p), dp.StartTimestamp())
+		})
+	}
+}
+
+func TestAccumulateHistograms(t *testing.T) {
+	// prepare
+	p := newTestProcessor(t, func(cfg *Config) {})
+
+	for _, tt := range []struct {
+		name        string
+		in          pmetric.Metrics
+		wantStart   pcommon.Timestamp
+		wantCount   uint64
+		wantSum     float64
+		wantBuckets []uint64
+		wantMin     float64
+		wantMax     float64
+	}{
+		{
+			name:        "first delta",
+			in:          deltaHistogram(10, 20, []float64{1, 10}, []uint64{1, 2, 1}, 15, 0.5, 12),
+			wantStart:   10,
+			wantCount:   4,
+			wantSum:     15,
+			wantBuckets: []uint64{1, 2, 1},
+			wantMin:     0.5,
+			wantMax:     12,
+		},
+		{
+			name:        "second delta",
+			in:          deltaHistogram(20, 30, []float64{1, 10}, []uint64{0, 3, 0}, 9, 2, 5),
+			wantStart:   10,
+			wantCount:   7,
+			wantSum:     24,
+			wantBuckets: []uint64{1, 5, 1},
+			wantMin:     0.5,
+			wantMax:     12,
+		},
+		{
+			name:        "bounds changed",
+			in:          deltaHistogram(30, 40, []float64{5}, []uint64{2, 1}, 11, 1, 8),
+			wantStart:   30,
+			wantCount:   3,
+			wantSum:     11,
+			wantBuckets: []uint64{2, 1},
+			wantMin:     1,
+			wantMax:     8,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// test
+			out, err := p.processMetrics(context.Background(), tt.in)
+
+			// verify
+			require.NoError(t, err)
+			hist := out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram()
+			assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, hist.AggregationTemporality())
+			require.Equal(t, 1, hist.DataPoints().Len())
+
+			dp := hist.DataPoints().At(0)
+			assert.Equal(t, tt.wantStart, dp.StartTimestamp())
+			assert.Equal(t, tt.wantCount, dp.Count())
+			assert.Equal(t, tt.wantSum, dp.Sum())
+			assert.Equal(t, tt.wantBuckets, dp.BucketCounts().AsRaw())
+			assert.Equal(t, tt.wantMin, dp.Min())
+			assert.Equal(t, tt.wantMax, dp.Max())
+		})
+	}
+}
+
+func TestIgnoredMetrics(t *testing.T) {
+	// prepare
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Exclude = MatchMetrics{
+			Config:  filterset.Config{MatchType: filterset.Strict},
+			Metrics: []string{"excluded"},
+		}
+	})
+
+	md := deltaSum("excluded", "a", 10, 20, 5)
+	cumulative := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().AppendEmpty()
+	cumulative.SetName("cumulative")
+	cumulative.SetDataType(pmetric.MetricDataTypeSum)
+	cumulative.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+	cumulative.Sum().DataPoints().AppendEmpty().SetIntVal(5)
+	gauge := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetDataType(pmetric.MetricDataTypeGauge)
+	gauge.Gauge().DataPoints().AppendEmpty().SetIntVal(5)
+	expected := md.Clone()
+
+	// test
+	out, err := p.processMetrics(context.Background(), md)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, expected, out)
+	assert.Len(t, p.streams, 0)
+}
+
+func newTestProcessor(t *testing.T, configure func(cfg *Config)) *deltaToCumulativeProcessor {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ProcessorSettings = config.NewProcessorSettings(config.NewComponentID(typeStr))
+	configure(cfg)
+	require.NoError(t, cfg.Validate())
+
+	p := newDeltaToCumulativeProcessor(cfg, zap.NewNop())
+	require.NoError(t, p.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, p.shutdown(context.Background()))
+	})
+	return p
+}
+
+func deltaSum(name string, stream string, start, ts pcommon.Timestamp, value int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("service.name", "test")
+
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(name)
+	m.SetDataType(pmetric.MetricDataTypeSum)
+	m.Sum().SetIsMonotonic(true)
+	m.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
+
+	dp := m.Sum().DataPoints().AppendEmpty()
+	dp.Attributes().InsertString("stream", stream)
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(value)
+	return md
+}
+
+func deltaHistogram(start, ts pcommon.Timestamp, bounds []float64, buckets []uint64, sum, min, max float64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetDataType(pmetric.MetricDataTypeHistogram)
+	m.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityDelta)
+
+	dp := m.Histogram().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetExplicitBounds(pcommon.NewImmutableFloat64Slice(bounds))
+	dp.SetBucketCounts(pcommon.NewImmutableUInt64Slice(buckets))
+	count := uint64(0)
+	for _, c := range buckets {
+		count += c
+	}
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetMin(min)
+	dp.SetMax(max)
+	return md
+}
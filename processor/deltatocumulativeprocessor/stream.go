@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const sep = byte(0x1E)
+
+// stream holds the accumulated state for a single series of delta data points
+type stream struct {
+	// start is the start timestamp of the cumulative series
+	start pcommon.Timestamp
+	// last is the timestamp of the last delta that was accumulated
+	last pcommon.Timestamp
+	// lastSeen is the time the last delta was received, used to expire the stream
+	lastSeen time.Time
+
+	intValue    int64
+	doubleValue float64
+	histogram   *histogramValue
+}
+
+type histogramValue struct {
+	count   uint64
+	sum     float64
+	min     float64
+	hasMin  bool
+	max     float64
+	hasMax  bool
+	bounds  []float64
+	buckets []uint64
+}
+
+// compatible returns whether the given bucket layout matches the accumulated one
+func (h *histogramValue) compatible(bounds []float64, buckets []uint64) bool {
+	if len(h.bounds) != len(bounds) || len(h.buckets) != len(buckets) {
+		return false
+	}
+	for i := range bounds {
+		if h.bounds[i] != bounds[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// metricIdentity builds the part of the stream identity shared by all the data points of a metric
+func metricIdentity(res pcommon.Resource, scope pcommon.InstrumentationScope, m pmetric.Metric) string {
+	b := strings.Builder{}
+	writeAttributes(&b, res.Attributes())
+	b.WriteByte(sep)
+	b.WriteString(scope.Name())
+	b.WriteByte(sep)
+	b.WriteString(scope.Version())
+	b.WriteByte(sep)
+	b.WriteString(m.DataType().String())
+	b.WriteByte(sep)
+	if m.DataType() == pmetric.MetricDataTypeSum && m.Sum().IsMonotonic() {
+		b.WriteByte('Y')
+	} else {
+		b.WriteByte('N')
+	}
+	b.WriteByte(sep)
+	b.WriteString(m.Name())
+	b.WriteByte(sep)
+	b.WriteString(m.Unit())
+	return b.String()
+}
+
+// streamIdentity builds the identity of a single stream, based on the identity of its metric
+func streamIdentity(base string, valueType string, attrs pcommon.Map) string {
+	b := strings.Builder{}
+	b.WriteString(base)
+	b.WriteByte(sep)
+	b.WriteString(valueType)
+	writeAttributes(&b, attrs)
+	return b.String()
+}
+
+// writeAttributes writes the attributes sorted by their keys, without changing the order of the original map
+func writeAttributes(b *strings.Builder, attrs pcommon.Map) {
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		b.WriteByte(sep)
+		b.WriteString(k)
+		b.WriteByte(':')
+		b.WriteString(v.AsString())
+	}
+}
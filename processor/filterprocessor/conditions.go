@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlmetrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlfunctions"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// conditionFunctions are the functions available to TQL conditions.
+var conditionFunctions = tqlfunctions.Converters()
+
+// parseMetricPath parses the paths of metric conditions, which are evaluated once per metric
+// and therefore cannot refer to the fields of a data point.
+func parseMetricPath(val *tql.Path) (tql.GetSetter, error) {
+	if val != nil && len(val.Fields) > 0 {
+		switch val.Fields[0].Name {
+		case "resource", "instrumentation_scope", "metric":
+			return tqlmetrics.ParsePath(val)
+		}
+		return nil, fmt.Errorf("invalid path %q for metric conditions, must start with resource, instrumentation_scope or metric", val.Fields[0].Name)
+	}
+	return nil, fmt.Errorf("bad path %v", val)
+}
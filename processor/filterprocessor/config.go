@@ -15,12 +15,20 @@
 package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/collector/config"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset/regexp"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqllogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlmetrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
 // Config defines configuration for Resource processor.
@@ -32,6 +40,8 @@ type Config struct {
 	Logs LogFilters `mapstructure:"logs"`
 
 	Spans SpanFilters `mapstructure:"spans"`
+
+	Traces TraceFilters `mapstructure:"traces"`
 }
 
 // MetricFilters filters by Metric properties.
@@ -48,6 +58,30 @@ type MetricFilters struct {
 
 	// RegexpConfig specifies options for the Regexp match type
 	RegexpConfig *regexp.Config `mapstructure:"regexp"`
+
+	// MetricConditions is a list of TQL conditions for a metric.
+	// A metric is dropped if any condition matches. Only the `resource`, `instrumentation_scope`
+	// and `metric` paths can be used.
+	// Cannot be used with Include or Exclude.
+	MetricConditions []string `mapstructure:"metric"`
+
+	// DataPointConditions is a list of TQL conditions for a data point.
+	// A data point is dropped if any condition matches, and a metric is dropped once all its data points are dropped.
+	// Cannot be used with Include or Exclude.
+	DataPointConditions []string `mapstructure:"datapoint"`
+}
+
+// TraceFilters filters by TQL conditions on spans and span events.
+type TraceFilters struct {
+	// SpanConditions is a list of TQL conditions for a span.
+	// A span is dropped, along with its span events, if any condition matches.
+	// Cannot be used with Spans.Include or Spans.Exclude.
+	SpanConditions []string `mapstructure:"span"`
+
+	// SpanEventConditions is a list of TQL conditions for a span event.
+	// A span event is dropped if any condition matches.
+	// Cannot be used with Spans.Include or Spans.Exclude.
+	SpanEventConditions []string `mapstructure:"spanevent"`
 }
 
 // SpanFilters filters by Span attributes and various other fields, Regexp config is per matcher
@@ -73,6 +107,11 @@ type LogFilters struct {
 	// all other logs should be included.
 	// If both Include and Exclude are specified, Include filtering occurs first.
 	Exclude *LogMatchProperties `mapstructure:"exclude"`
+
+	// LogConditions is a list of TQL conditions for a log record.
+	// A log record is dropped if any condition matches.
+	// Cannot be used with Include or Exclude.
+	LogConditions []string `mapstructure:"log_record"`
 }
 
 // LogMatchType specifies the strategy for matching against `plog.Log`s.
@@ -104,5 +143,31 @@ var _ config.Processor = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
-	return nil
+	var errs error
+	if (cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
+		errs = multierr.Append(errs, errors.New("cannot use TQL conditions and include/exclude for spans at the same time"))
+	}
+	if (cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil) && (cfg.Metrics.Include != nil || cfg.Metrics.Exclude != nil) {
+		errs = multierr.Append(errs, errors.New("cannot use TQL conditions and include/exclude for metrics at the same time"))
+	}
+	if cfg.Logs.LogConditions != nil && (cfg.Logs.Include != nil || cfg.Logs.Exclude != nil) {
+		errs = multierr.Append(errs, errors.New("cannot use TQL conditions and include/exclude for logs at the same time"))
+	}
+
+	if _, err := tql.ParseConditions(cfg.Traces.SpanConditions, conditionFunctions, tqltraces.ParsePath); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid span conditions: %w", err))
+	}
+	if _, err := tql.ParseConditions(cfg.Traces.SpanEventConditions, conditionFunctions, tqltraces.ParseSpanEventPath); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid span event conditions: %w", err))
+	}
+	if _, err := tql.ParseConditions(cfg.Metrics.MetricConditions, conditionFunctions, parseMetricPath); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid metric conditions: %w", err))
+	}
+	if _, err := tql.ParseConditions(cfg.Metrics.DataPointConditions, conditionFunctions, tqlmetrics.ParsePath); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid data point conditions: %w", err))
+	}
+	if _, err := tql.ParseConditions(cfg.Logs.LogConditions, conditionFunctions, tqllogs.ParsePath); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid log record conditions: %w", err))
+	}
+	return errs
 }
@@ -397,3 +397,107 @@ func TestLoadingConfigExpr(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadingConfigTQL(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	factory := NewFactory()
+	factories.Processors[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config_tql.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	expCfg := &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "tql")),
+		Traces: TraceFilters{
+			SpanConditions: []string{
+				`attributes["test"] == "pass"`,
+				`name == "operationA" and resource.attributes["host.name"] == "localhost"`,
+			},
+			SpanEventConditions: []string{
+				`attributes["grpc"] == true`,
+			},
+		},
+		Metrics: MetricFilters{
+			MetricConditions: []string{
+				`metric.name == "my.metric" and resource.attributes["my_label"] == "abc123"`,
+				`metric.type == "Histogram"`,
+			},
+			DataPointConditions: []string{
+				`metric.type == "Sum" and attributes["foo"] == "bar"`,
+			},
+		},
+		Logs: LogFilters{
+			LogConditions: []string{
+				`body == "drop me"`,
+				`severity_number < 9`,
+			},
+		},
+	}
+	assert.Equal(t, expCfg, cfg.Processors[expCfg.ID()])
+}
+
+func TestValidateTQLConditions(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *Config
+		errMsg string
+	}{
+		{
+			name: "span conditions with include",
+			cfg: &Config{
+				Spans:  SpanFilters{Include: &filterconfig.MatchProperties{}},
+				Traces: TraceFilters{SpanConditions: []string{`name == "operationA"`}},
+			},
+			errMsg: "cannot use TQL conditions and include/exclude for spans at the same time",
+		},
+		{
+			name: "data point conditions with exclude",
+			cfg: &Config{
+				Metrics: MetricFilters{
+					Exclude:             &filtermetric.MatchProperties{},
+					DataPointConditions: []string{`attributes["foo"] == "bar"`},
+				},
+			},
+			errMsg: "cannot use TQL conditions and include/exclude for metrics at the same time",
+		},
+		{
+			name: "log conditions with include",
+			cfg: &Config{
+				Logs: LogFilters{
+					Include:       &LogMatchProperties{},
+					LogConditions: []string{`body == "drop me"`},
+				},
+			},
+			errMsg: "cannot use TQL conditions and include/exclude for logs at the same time",
+		},
+		{
+			name: "invalid span event path",
+			cfg: &Config{
+				Traces: TraceFilters{SpanEventConditions: []string{`trace_state == "key=value"`}},
+			},
+			errMsg: "invalid span event conditions",
+		},
+		{
+			name: "data point path in metric conditions",
+			cfg: &Config{
+				Metrics: MetricFilters{MetricConditions: []string{`attributes["foo"] == "bar"`}},
+			},
+			errMsg: `invalid path "attributes" for metric conditions`,
+		},
+		{
+			name: "invalid log condition",
+			cfg: &Config{
+				Logs: LogFilters{LogConditions: []string{`set(body, "dropped")`}},
+			},
+			errMsg: "invalid log record conditions",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -26,20 +26,27 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlmetrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
 type filterMetricProcessor struct {
-	cfg              *Config
-	include          filtermetric.Matcher
-	includeAttribute filtermatcher.AttributesMatcher
-	exclude          filtermetric.Matcher
-	excludeAttribute filtermatcher.AttributesMatcher
-	logger           *zap.Logger
-	checksMetrics    bool
-	checksResouces   bool
+	cfg                 *Config
+	include             filtermetric.Matcher
+	includeAttribute    filtermatcher.AttributesMatcher
+	exclude             filtermetric.Matcher
+	excludeAttribute    filtermatcher.AttributesMatcher
+	metricConditions    []tql.CondFunc
+	dataPointConditions []tql.CondFunc
+	logger              *zap.Logger
+	checksMetrics       bool
+	checksResouces      bool
 }
 
 func newFilterMetricProcessor(logger *zap.Logger, cfg *Config) (*filterMetricProcessor, error) {
+	if cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil {
+		return newFilterMetricConditionsProcessor(logger, cfg)
+	}
 
 	inc, includeAttr, err := createMatcher(cfg.Metrics.Include)
 	if err != nil {
@@ -102,6 +109,30 @@ func newFilterMetricProcessor(logger *zap.Logger, cfg *Config) (*filterMetricPro
 	}, nil
 }
 
+func newFilterMetricConditionsProcessor(logger *zap.Logger, cfg *Config) (*filterMetricProcessor, error) {
+	metricConditions, err := tql.ParseConditions(cfg.Metrics.MetricConditions, conditionFunctions, parseMetricPath)
+	if err != nil {
+		return nil, err
+	}
+	dataPointConditions, err := tql.ParseConditions(cfg.Metrics.DataPointConditions, conditionFunctions, tqlmetrics.ParsePath)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"Metric filter configured",
+		zap.Strings("metric conditions", cfg.Metrics.MetricConditions),
+		zap.Strings("data point conditions", cfg.Metrics.DataPointConditions),
+	)
+
+	return &filterMetricProcessor{
+		cfg:                 cfg,
+		metricConditions:    metricConditions,
+		dataPointConditions: dataPointConditions,
+		logger:              logger,
+	}, nil
+}
+
 func createMatcher(mp *filtermetric.MatchProperties) (filtermetric.Matcher, filtermatcher.AttributesMatcher, error) {
 	// Nothing specified in configuration
 	if mp == nil {
@@ -125,6 +156,10 @@ func createMatcher(mp *filtermetric.MatchProperties) (filtermetric.Matcher, filt
 
 // processMetrics filters the given metrics based off the filterMetricProcessor's filters.
 func (fmp *filterMetricProcessor) processMetrics(_ context.Context, pdm pmetric.Metrics) (pmetric.Metrics, error) {
+	if fmp.metricConditions != nil || fmp.dataPointConditions != nil {
+		return fmp.processMetricsConditions(pdm)
+	}
+
 	pdm.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
 		keepMetricsForResource := fmp.shouldKeepMetricsForResource(rm.Resource())
 		if !keepMetricsForResource {
@@ -156,6 +191,73 @@ func (fmp *filterMetricProcessor) processMetrics(_ context.Context, pdm pmetric.
 	return pdm, nil
 }
 
+// processMetricsConditions drops the metrics and data points matching any of the filterMetricProcessor's conditions.
+func (fmp *filterMetricProcessor) processMetricsConditions(pdm pmetric.Metrics) (pmetric.Metrics, error) {
+	pdm.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			metrics := sm.Metrics()
+			metrics.RemoveIf(func(m pmetric.Metric) bool {
+				newContext := func(dataPoint interface{}) tql.TransformContext {
+					return tqlmetrics.NewTransformContext(dataPoint, m, metrics, sm.Scope(), rm.Resource())
+				}
+				if tql.MatchesAnyCondition(fmp.metricConditions, newContext(nil)) {
+					return true
+				}
+				if len(fmp.dataPointConditions) == 0 {
+					return false
+				}
+				return fmp.removeDataPoints(m, newContext)
+			})
+			// Filter out empty ScopeMetrics
+			return metrics.Len() == 0
+		})
+		// Filter out empty ResourceMetrics
+		return rm.ScopeMetrics().Len() == 0
+	})
+	if pdm.ResourceMetrics().Len() == 0 {
+		return pdm, processorhelper.ErrSkipProcessingData
+	}
+	return pdm, nil
+}
+
+// removeDataPoints drops the data points of the metric matching any data point condition.
+// True is returned when the metric has no data points left.
+func (fmp *filterMetricProcessor) removeDataPoints(metric pmetric.Metric, newContext func(interface{}) tql.TransformContext) bool {
+	switch metric.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			return tql.MatchesAnyCondition(fmp.dataPointConditions, newContext(dp))
+		})
+		return dps.Len() == 0
+	case pmetric.MetricDataTypeSum:
+		dps := metric.Sum().DataPoints()
+		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			return tql.MatchesAnyCondition(fmp.dataPointConditions, newContext(dp))
+		})
+		return dps.Len() == 0
+	case pmetric.MetricDataTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+			return tql.MatchesAnyCondition(fmp.dataPointConditions, newContext(dp))
+		})
+		return dps.Len() == 0
+	case pmetric.MetricDataTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		dps.RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+			return tql.MatchesAnyCondition(fmp.dataPointConditions, newContext(dp))
+		})
+		return dps.Len() == 0
+	case pmetric.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		dps.RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+			return tql.MatchesAnyCondition(fmp.dataPointConditions, newContext(dp))
+		})
+		return dps.Len() == 0
+	}
+	return false
+}
+
 func (fmp *filterMetricProcessor) shouldKeepMetric(metric pmetric.Metric) (bool, error) {
 	if fmp.include != nil {
 		matches, err := fmp.include.MatchMetric(metric)
@@ -25,6 +25,8 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqllogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
 type filterLogProcessor struct {
@@ -33,6 +35,7 @@ type filterLogProcessor struct {
 	excludeRecords   filtermatcher.AttributesMatcher
 	includeResources filtermatcher.AttributesMatcher
 	includeRecords   filtermatcher.AttributesMatcher
+	logConditions    []tql.CondFunc
 	logger           *zap.Logger
 }
 
@@ -70,12 +73,21 @@ func newFilterLogsProcessor(logger *zap.Logger, cfg *Config) (*filterLogProcesso
 		return nil, err
 	}
 
+	logConditions, err := tql.ParseConditions(cfg.Logs.LogConditions, conditionFunctions, tqllogs.ParsePath)
+	if err != nil {
+		logger.Error(
+			"filterlog: Error parsing log record conditions", zap.Error(err),
+		)
+		return nil, err
+	}
+
 	return &filterLogProcessor{
 		cfg:              cfg,
 		includeResources: includeResources,
 		includeRecords:   includeRecords,
 		excludeResources: excludeResources,
 		excludeRecords:   excludeRecords,
+		logConditions:    logConditions,
 		logger:           logger,
 	}, nil
 }
@@ -124,7 +136,7 @@ func (flp *filterLogProcessor) ProcessLogs(ctx context.Context, logs plog.Logs)
 		return flp.shouldSkipLogsForResource(rm.Resource())
 	})
 
-	// Filter logs by record level attributes
+	// Filter logs by record level attributes and conditions
 	flp.filterByRecordAttributes(rLogs)
 
 	if rLogs.Len() == 0 {
@@ -136,13 +148,16 @@ func (flp *filterLogProcessor) ProcessLogs(ctx context.Context, logs plog.Logs)
 
 func (flp *filterLogProcessor) filterByRecordAttributes(rLogs plog.ResourceLogsSlice) {
 	for i := 0; i < rLogs.Len(); i++ {
-		ills := rLogs.At(i).ScopeLogs()
+		rl := rLogs.At(i)
+		ills := rl.ScopeLogs()
 
 		for j := 0; j < ills.Len(); j++ {
-			ls := ills.At(j).LogRecords()
+			sl := ills.At(j)
+			ls := sl.LogRecords()
 
 			ls.RemoveIf(func(lr plog.LogRecord) bool {
-				return flp.shouldSkipLogsForRecord(lr)
+				return flp.shouldSkipLogsForRecord(lr) ||
+					tql.MatchesAnyCondition(flp.logConditions, tqllogs.NewTransformContext(lr, sl.Scope(), rl.Resource()))
 			})
 		}
 
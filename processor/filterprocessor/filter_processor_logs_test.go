@@ -449,3 +449,85 @@ func requireNotPanicsLogs(t *testing.T, logs plog.Logs) {
 		_ = proc.ConsumeLogs(ctx, logs)
 	})
 }
+
+func TestFilterLogProcessorWithTQL(t *testing.T) {
+	tests := []struct {
+		name             string
+		conditions       []string
+		filterEverything bool
+		want             []string
+	}{
+		{
+			name:       "drop logs by body",
+			conditions: []string{`body == "operationA"`},
+			want:       []string{"operationB", "operationC"},
+		},
+		{
+			name:       "drop logs by severity and scope",
+			conditions: []string{`severity_number < 9 and instrumentation_scope.name == "scope"`},
+			want:       []string{"operationA", "operationC"},
+		},
+		{
+			name:       "drop logs by attribute or resource",
+			conditions: []string{`attributes["http.method"] == "get"`, `resource.attributes["host.name"] == "remote"`},
+			want:       []string{"operationB", "operationC"},
+		},
+		{
+			name:             "drop everything",
+			conditions:       []string{`resource.attributes["host.name"] == "localhost"`},
+			filterEverything: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			next := new(consumertest.LogsSink)
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Logs: LogFilters{
+					LogConditions: tt.conditions,
+				},
+			}
+			require.NoError(t, cfg.Validate())
+			flp, err := NewFactory().CreateLogsProcessor(ctx, componenttest.NewNopProcessorCreateSettings(), cfg, next)
+			require.NoError(t, err)
+
+			require.NoError(t, flp.ConsumeLogs(ctx, constructTQLLogs()))
+			got := next.AllLogs()
+			if tt.filterEverything {
+				require.Len(t, got, 0)
+				return
+			}
+			require.Len(t, got, 1)
+
+			var bodies []string
+			logs := got[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+			for i := 0; i < logs.Len(); i++ {
+				bodies = append(bodies, logs.At(i).Body().StringVal())
+			}
+			assert.Equal(t, tt.want, bodies)
+		})
+	}
+}
+
+func constructTQLLogs() plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("host.name", "localhost")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+
+	logA := sl.LogRecords().AppendEmpty()
+	logA.Body().SetStringVal("operationA")
+	logA.SetSeverityNumber(plog.SeverityNumberINFO)
+	logA.Attributes().InsertString("http.method", "get")
+
+	logB := sl.LogRecords().AppendEmpty()
+	logB.Body().SetStringVal("operationB")
+	logB.SetSeverityNumber(plog.SeverityNumberDEBUG)
+
+	logC := sl.LogRecords().AppendEmpty()
+	logC.Body().SetStringVal("operationC")
+	logC.SetSeverityNumber(plog.SeverityNumberERROR)
+	return ld
+}
@@ -519,3 +519,106 @@ func requireNotPanics(t *testing.T, metrics pmetric.Metrics) {
 		_ = proc.ConsumeMetrics(ctx, metrics)
 	})
 }
+
+func TestFilterMetricProcessorWithTQL(t *testing.T) {
+	tests := []struct {
+		name                string
+		metricConditions    []string
+		dataPointConditions []string
+		filterEverything    bool
+		want                map[string]int // data points left per metric name
+	}{
+		{
+			name:             "drop metrics",
+			metricConditions: []string{`metric.name == "operationA"`},
+			want:             map[string]int{"operationB": 1, "operationC": 2},
+		},
+		{
+			name:             "drop metrics by type",
+			metricConditions: []string{`metric.type == "Histogram"`},
+			want:             map[string]int{"operationA": 2, "operationB": 1},
+		},
+		{
+			name:                "drop data points",
+			dataPointConditions: []string{`attributes["attr"] == "test1"`},
+			want:                map[string]int{"operationA": 1, "operationC": 1},
+		},
+		{
+			name:                "drop data points by metric",
+			dataPointConditions: []string{`metric.name == "operationA" and value_int > 5`},
+			want:                map[string]int{"operationA": 1, "operationB": 1, "operationC": 2},
+		},
+		{
+			name:                "drop everything",
+			metricConditions:    []string{`resource.attributes["host.name"] == "localhost"`},
+			dataPointConditions: []string{`attributes["attr"] == "test1"`},
+			filterEverything:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			next := new(consumertest.MetricsSink)
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Metrics: MetricFilters{
+					MetricConditions:    tt.metricConditions,
+					DataPointConditions: tt.dataPointConditions,
+				},
+			}
+			require.NoError(t, cfg.Validate())
+			fmp, err := NewFactory().CreateMetricsProcessor(ctx, componenttest.NewNopProcessorCreateSettings(), cfg, next)
+			require.NoError(t, err)
+
+			require.NoError(t, fmp.ConsumeMetrics(ctx, constructTQLMetrics()))
+			got := next.AllMetrics()
+			if tt.filterEverything {
+				require.Len(t, got, 0)
+				return
+			}
+			require.Len(t, got, 1)
+
+			dataPoints := map[string]int{}
+			metrics := got[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				m := metrics.At(i)
+				switch m.DataType() {
+				case pmetric.MetricDataTypeGauge:
+					dataPoints[m.Name()] = m.Gauge().DataPoints().Len()
+				case pmetric.MetricDataTypeSum:
+					dataPoints[m.Name()] = m.Sum().DataPoints().Len()
+				case pmetric.MetricDataTypeHistogram:
+					dataPoints[m.Name()] = m.Histogram().DataPoints().Len()
+				}
+			}
+			assert.Equal(t, tt.want, dataPoints)
+		})
+	}
+}
+
+func constructTQLMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("host.name", "localhost")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("operationA")
+	gauge.SetDataType(pmetric.MetricDataTypeGauge)
+	dp := gauge.Gauge().DataPoints().AppendEmpty()
+	dp.SetIntVal(10)
+	dp.Attributes().InsertString("attr", "test1")
+	gauge.Gauge().DataPoints().AppendEmpty().SetIntVal(1)
+
+	sum := metrics.AppendEmpty()
+	sum.SetName("operationB")
+	sum.SetDataType(pmetric.MetricDataTypeSum)
+	sum.Sum().DataPoints().AppendEmpty().Attributes().InsertString("attr", "test1")
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("operationC")
+	histogram.SetDataType(pmetric.MetricDataTypeHistogram)
+	histogram.Histogram().DataPoints().AppendEmpty().Attributes().InsertString("attr", "test1")
+	histogram.Histogram().DataPoints().AppendEmpty().Attributes().InsertString("attr", "test2")
+	return md
+}
@@ -24,16 +24,24 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
 type filterSpanProcessor struct {
-	cfg     *Config
-	include filterspan.Matcher
-	exclude filterspan.Matcher
-	logger  *zap.Logger
+	cfg                 *Config
+	include             filterspan.Matcher
+	exclude             filterspan.Matcher
+	spanConditions      []tql.CondFunc
+	spanEventConditions []tql.CondFunc
+	logger              *zap.Logger
 }
 
 func newFilterSpansProcessor(logger *zap.Logger, cfg *Config) (*filterSpanProcessor, error) {
+	if cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil {
+		return newFilterSpansConditionsProcessor(logger, cfg)
+	}
+
 	if cfg.Spans.Include == nil && cfg.Spans.Exclude == nil {
 		return nil, nil
 	}
@@ -67,6 +75,31 @@ func newFilterSpansProcessor(logger *zap.Logger, cfg *Config) (*filterSpanProces
 	}, nil
 }
 
+func newFilterSpansConditionsProcessor(logger *zap.Logger, cfg *Config) (*filterSpanProcessor, error) {
+	spanConditions, err := tql.ParseConditions(cfg.Traces.SpanConditions, conditionFunctions, tqltraces.ParsePath)
+	if err != nil {
+		return nil, err
+	}
+	spanEventConditions, err := tql.ParseConditions(cfg.Traces.SpanEventConditions, conditionFunctions, tqltraces.ParseSpanEventPath)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"Span filter configured",
+		zap.String("ID", cfg.ID().String()),
+		zap.Strings("span conditions", cfg.Traces.SpanConditions),
+		zap.Strings("span event conditions", cfg.Traces.SpanEventConditions),
+	)
+
+	return &filterSpanProcessor{
+		cfg:                 cfg,
+		spanConditions:      spanConditions,
+		spanEventConditions: spanEventConditions,
+		logger:              logger,
+	}, nil
+}
+
 func createSpanMatcher(cfg *Config) (filterspan.Matcher, filterspan.Matcher, error) {
 	var includeMatcher filterspan.Matcher
 	var excludeMatcher filterspan.Matcher
@@ -93,7 +126,15 @@ func (fsp *filterSpanProcessor) processTraces(_ context.Context, pdt ptrace.Trac
 		for x := 0; x < resSpan.ScopeSpans().Len(); x++ {
 			ils := resSpan.ScopeSpans().At(x)
 			ils.Spans().RemoveIf(func(span ptrace.Span) bool {
-				return fsp.shouldRemoveSpan(span, resSpan.Resource(), ils.Scope())
+				if fsp.shouldRemoveSpan(span, resSpan.Resource(), ils.Scope()) {
+					return true
+				}
+				if len(fsp.spanEventConditions) > 0 {
+					span.Events().RemoveIf(func(event ptrace.SpanEvent) bool {
+						return tql.MatchesAnyCondition(fsp.spanEventConditions, tqltraces.NewSpanEventTransformContext(event, span, ils.Scope(), resSpan.Resource()))
+					})
+				}
+				return false
 			})
 		}
 		// Remove empty elements, that way if we delete everything we can tell
@@ -124,5 +165,9 @@ func (fsp *filterSpanProcessor) shouldRemoveSpan(span ptrace.Span, resource pcom
 		}
 	}
 
+	if len(fsp.spanConditions) > 0 {
+		return tql.MatchesAnyCondition(fsp.spanConditions, tqltraces.NewTransformContext(span, library, resource))
+	}
+
 	return false
 }
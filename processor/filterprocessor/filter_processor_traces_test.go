@@ -181,3 +181,103 @@ func generateTraces(traces []testTrace) ptrace.Traces {
 	}
 	return td
 }
+
+func TestFilterTraceProcessorWithTQL(t *testing.T) {
+	tests := []struct {
+		name                string
+		spanConditions      []string
+		spanEventConditions []string
+		filterEverything    bool
+		wantSpans           []string
+		wantEvents          int
+	}{
+		{
+			name:           "drop spans",
+			spanConditions: []string{`name == "operationA"`},
+			wantSpans:      []string{"operationB"},
+			wantEvents:     1,
+		},
+		{
+			name:           "drop spans by resource",
+			spanConditions: []string{`resource.attributes["host.name"] == "localhost" and attributes["http.method"] == "get"`},
+			wantSpans:      []string{"operationB"},
+			wantEvents:     1,
+		},
+		{
+			name:           "drop spans with a function",
+			spanConditions: []string{`IsMatch(name, "A$") == true`},
+			wantSpans:      []string{"operationB"},
+			wantEvents:     1,
+		},
+		{
+			name:                "drop span events",
+			spanEventConditions: []string{`name == "exception" and span.name == "operationA"`},
+			wantSpans:           []string{"operationA", "operationB"},
+			wantEvents:          2,
+		},
+		{
+			name:             "drop everything",
+			spanConditions:   []string{`name == "operationA"`, `name == "operationB"`},
+			filterEverything: true,
+		},
+		{
+			name:           "no match",
+			spanConditions: []string{`attributes["http.method"] == "post"`},
+			wantSpans:      []string{"operationA", "operationB"},
+			wantEvents:     3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			next := new(consumertest.TracesSink)
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Traces: TraceFilters{
+					SpanConditions:      tt.spanConditions,
+					SpanEventConditions: tt.spanEventConditions,
+				},
+			}
+			require.NoError(t, cfg.Validate())
+			fsp, err := NewFactory().CreateTracesProcessor(ctx, componenttest.NewNopProcessorCreateSettings(), cfg, next)
+			require.NoError(t, err)
+
+			require.NoError(t, fsp.ConsumeTraces(ctx, constructTQLTraces()))
+			got := next.AllTraces()
+			if tt.filterEverything {
+				require.Len(t, got, 0)
+				return
+			}
+			require.Len(t, got, 1)
+
+			var names []string
+			events := 0
+			spans := got[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+			for i := 0; i < spans.Len(); i++ {
+				names = append(names, spans.At(i).Name())
+				events += spans.At(i).Events().Len()
+			}
+			require.Equal(t, tt.wantSpans, names)
+			require.Equal(t, tt.wantEvents, events)
+		})
+	}
+}
+
+func constructTQLTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("host.name", "localhost")
+	ss := rs.ScopeSpans().AppendEmpty()
+
+	spanA := ss.Spans().AppendEmpty()
+	spanA.SetName("operationA")
+	spanA.Attributes().InsertString("http.method", "get")
+	spanA.Events().AppendEmpty().SetName("exception")
+	spanA.Events().AppendEmpty().SetName("log")
+
+	spanB := ss.Spans().AppendEmpty()
+	spanB.SetName("operationB")
+	spanB.Attributes().InsertString("http.method", "put")
+	spanB.Events().AppendEmpty().SetName("exception")
+	return td
+}
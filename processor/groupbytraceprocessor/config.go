@@ -42,9 +42,13 @@ type Config struct {
 	// Not yet implemented, and an error will be returned when this option is used.
 	DiscardOrphans bool `mapstructure:"discard_orphans"`
 
-	// StoreOnDisk tells the processor to keep only the trace ID in memory, serializing the trace spans to disk.
+	// StoreOnDisk tells the processor to keep only the trace ID in memory, serializing the trace spans to disk
+	// through the storage extension referenced by StorageID. Traces that were waiting when the collector stopped
+	// are recovered on the next start.
 	// Useful when the duration to wait for traces to complete is high.
 	// Default: false.
-	// Not yet implemented, and an error will be returned when this option is used.
 	StoreOnDisk bool `mapstructure:"store_on_disk"`
+
+	// StorageID is the ID of the storage extension used when StoreOnDisk is enabled, such as file_storage.
+	StorageID *config.ComponentID `mapstructure:"storage"`
 }
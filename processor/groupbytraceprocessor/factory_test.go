@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 )
 
 func TestDefaultConfiguration(t *testing.T) {
@@ -63,12 +64,6 @@ func TestCreateTestProcessorWithNotImplementedOptions(t *testing.T) {
 			},
 			errDiscardOrphansNotSupported,
 		},
-		{
-			&Config{
-				StoreOnDisk: true,
-			},
-			errDiskStorageNotSupported,
-		},
 	} {
 		p, err := f.CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), tt.config, next)
 
@@ -77,3 +72,28 @@ func TestCreateTestProcessorWithNotImplementedOptions(t *testing.T) {
 		assert.Nil(t, p)
 	}
 }
+
+func TestCreateTestProcessorStoreOnDisk(t *testing.T) {
+	// prepare
+	c := createDefaultConfig().(*Config)
+	c.StoreOnDisk = true
+	next := &mockProcessor{}
+
+	// test
+	p, err := createTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), c, next)
+
+	// verify
+	assert.ErrorIs(t, err, errStorageIDNotConfigured)
+	assert.Nil(t, p)
+
+	// prepare
+	storageID := config.NewComponentID("file_storage")
+	c.StorageID = &storageID
+
+	// test
+	p, err = createTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), c, next)
+
+	// verify
+	assert.NoError(t, err)
+	assert.IsType(t, &diskStorage{}, p.(*groupByTraceProcessor).st)
+}
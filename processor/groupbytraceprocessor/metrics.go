@@ -25,6 +25,7 @@ var (
 	mNumTracesConf      = stats.Int64("processor_groupbytrace_conf_num_traces", "Maximum number of traces to hold in the internal storage", stats.UnitDimensionless)
 	mNumEventsInQueue   = stats.Int64("processor_groupbytrace_num_events_in_queue", "Number of events currently in the queue", stats.UnitDimensionless)
 	mNumTracesInMemory  = stats.Int64("processor_groupbytrace_num_traces_in_memory", "Number of traces currently in the in-memory storage", stats.UnitDimensionless)
+	mNumTracesOnDisk    = stats.Int64("processor_groupbytrace_num_traces_on_disk", "Number of traces currently in the disk storage", stats.UnitDimensionless)
 	mTracesEvicted      = stats.Int64("processor_groupbytrace_traces_evicted", "Traces evicted from the internal buffer", stats.UnitDimensionless)
 	mReleasedSpans      = stats.Int64("processor_groupbytrace_spans_released", "Spans released to the next consumer", stats.UnitDimensionless)
 	mReleasedTraces     = stats.Int64("processor_groupbytrace_traces_released", "Traces released to the next consumer", stats.UnitDimensionless)
@@ -87,5 +88,11 @@ func MetricViews() []*view.View {
 			},
 			Aggregation: view.Distribution(0, 5, 10, 20, 50, 100, 200, 500, 1000),
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumTracesOnDisk.Name()),
+			Measure:     mNumTracesOnDisk,
+			Description: mNumTracesOnDisk.Description(),
+			Aggregation: view.LastValue(),
+		},
 	}
 }
@@ -30,6 +30,7 @@ func TestProcessorMetrics(t *testing.T) {
 		"processor/groupbytrace/processor_groupbytrace_traces_released",
 		"processor/groupbytrace/processor_groupbytrace_incomplete_releases",
 		"processor/groupbytrace/processor_groupbytrace_event_latency",
+		"processor/groupbytrace/processor_groupbytrace_num_traces_on_disk",
 	}
 
 	views := MetricViews()
@@ -93,14 +93,48 @@ func (sp *groupByTraceProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (sp *groupByTraceProcessor) Start(context.Context, component.Host) error {
+func (sp *groupByTraceProcessor) Start(ctx context.Context, host component.Host) error {
 	// start these metrics, as it might take a while for them to receive their first event
 	stats.Record(context.Background(), mTracesEvicted.M(0))
 	stats.Record(context.Background(), mIncompleteReleases.M(0))
 	stats.Record(context.Background(), mNumTracesConf.M(int64(sp.config.NumTraces)))
 
 	sp.eventMachine.startInBackground()
-	return sp.st.start()
+	if err := sp.st.start(ctx, host); err != nil {
+		return err
+	}
+
+	if rs, ok := sp.st.(recoverableStorage); ok {
+		sp.recoverTraces(ctx, rs)
+	}
+	return nil
+}
+
+// recoverTraces feeds the traces that were waiting when the processor last stopped back into
+// the event machine, so that they wait for their remaining spans again before being released.
+func (sp *groupByTraceProcessor) recoverTraces(ctx context.Context, st recoverableStorage) {
+	traceIDs := st.recovered()
+	if len(traceIDs) == 0 {
+		return
+	}
+	sp.logger.Info("recovering traces from the storage", zap.Int("traces", len(traceIDs)))
+
+	for _, traceID := range traceIDs {
+		// the trace is removed from the storage, as it will be stored again once it's received
+		rss, err := st.delete(traceID)
+		if err != nil {
+			sp.logger.Error("couldn't recover trace from the storage", zap.String("traceID", traceID.HexString()), zap.Error(err))
+			continue
+		}
+
+		td := ptrace.NewTraces()
+		for _, rs := range rss {
+			rs.MoveTo(td.ResourceSpans().AppendEmpty())
+		}
+		if err := sp.ConsumeTraces(ctx, td); err != nil {
+			sp.logger.Error("couldn't recover trace", zap.String("traceID", traceID.HexString()), zap.Error(err))
+		}
+	}
 }
 
 // Shutdown is invoked during service shutdown.
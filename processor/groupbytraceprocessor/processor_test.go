@@ -29,6 +29,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
 )
 
@@ -640,7 +641,7 @@ func (st *mockStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans,
 	}
 	return nil, nil
 }
-func (st *mockStorage) start() error {
+func (st *mockStorage) start(context.Context, component.Host) error {
 	if st.onStart != nil {
 		return st.onStart()
 	}
@@ -678,3 +679,48 @@ func simpleTracesWithID(traceID pcommon.TraceID) ptrace.Traces {
 	ils.Spans().AppendEmpty().SetTraceID(traceID)
 	return traces
 }
+
+func TestTracesAreRecoveredFromDisk(t *testing.T) {
+	// prepare
+	traces := simpleTraces()
+	ctx := context.Background()
+	dir := t.TempDir()
+	config := Config{
+		WaitDuration: time.Hour,
+		NumTraces:    10,
+		NumWorkers:   1,
+	}
+
+	st := newDiskStorage(zap.NewNop(), testStorageID, config.ID())
+	p := newGroupByTraceProcessor(zap.NewNop(), st, &mockProcessor{}, config)
+	require.NoError(t, p.Start(ctx, storagetest.NewStorageHost(t, dir, "test")))
+	require.NoError(t, p.ConsumeTraces(ctx, traces))
+	assert.Eventually(t, func() bool {
+		return st.count() == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, p.Shutdown(ctx))
+
+	// test
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	next := &mockProcessor{
+		onTraces: func(ctx context.Context, received ptrace.Traces) error {
+			assert.Equal(t, traces, received)
+			wg.Done()
+			return nil
+		},
+	}
+	config.WaitDuration = time.Millisecond
+	st = newDiskStorage(zap.NewNop(), testStorageID, config.ID())
+	p = newGroupByTraceProcessor(zap.NewNop(), st, next, config)
+	require.NoError(t, p.Start(ctx, storagetest.NewStorageHost(t, dir, "test")))
+	defer func() {
+		assert.NoError(t, p.Shutdown(ctx))
+	}()
+
+	// verify
+	wg.Wait()
+	assert.Eventually(t, func() bool {
+		return st.count() == 0
+	}, time.Second, 10*time.Millisecond)
+}
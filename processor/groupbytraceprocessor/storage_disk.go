@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	extstorage "go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+const (
+	// indexKey is the key under which the IDs of the stored traces are persisted
+	indexKey = "trace_ids"
+	// traceKeyPrefix is the prefix of the keys under which the spans of each trace are persisted
+	traceKeyPrefix = "trace_"
+)
+
+var (
+	errStorageExtensionNotFound = errors.New("storage extension not found")
+	errNotStorageExtension      = errors.New("extension is not a storage extension")
+
+	tracesMarshaler   = ptrace.NewProtoMarshaler()
+	tracesUnmarshaler = ptrace.NewProtoUnmarshaler()
+)
+
+// diskStorage keeps only the trace IDs in memory, storing the spans through a storage extension client.
+// The trace IDs are persisted along with the first spans of each trace, so that the traces can be recovered
+// after a restart, while the removals of traces are persisted periodically.
+type diskStorage struct {
+	logger      *zap.Logger
+	storageID   config.ComponentID
+	processorID config.ComponentID
+	client      extstorage.Client
+
+	sync.Mutex
+	traceIDs       map[pcommon.TraceID]struct{}
+	recoveredIDs   []pcommon.TraceID
+	indexDirty     bool
+	stopCh         chan struct{}
+	wg             sync.WaitGroup
+	tickerInterval time.Duration
+}
+
+var _ recoverableStorage = (*diskStorage)(nil)
+
+func newDiskStorage(logger *zap.Logger, storageID config.ComponentID, processorID config.ComponentID) *diskStorage {
+	return &diskStorage{
+		logger:         logger,
+		storageID:      storageID,
+		processorID:    processorID,
+		traceIDs:       make(map[pcommon.TraceID]struct{}),
+		stopCh:         make(chan struct{}),
+		tickerInterval: time.Second,
+	}
+}
+
+func (st *diskStorage) start(ctx context.Context, host component.Host) error {
+	ext, found := host.GetExtensions()[st.storageID]
+	if !found {
+		return fmt.Errorf("%w: %s", errStorageExtensionNotFound, st.storageID)
+	}
+	storageExt, ok := ext.(extstorage.Extension)
+	if !ok {
+		return fmt.Errorf("%w: %s", errNotStorageExtension, st.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindProcessor, st.processorID, "")
+	if err != nil {
+		return err
+	}
+	st.client = client
+
+	if err := st.loadIndex(ctx); err != nil {
+		_ = client.Close(ctx)
+		st.client = nil
+		return err
+	}
+
+	st.wg.Add(1)
+	go st.periodicFlush()
+	return nil
+}
+
+func (st *diskStorage) loadIndex(ctx context.Context) error {
+	buf, err := st.client.Get(ctx, indexKey)
+	if err != nil {
+		return fmt.Errorf("couldn't read the trace IDs from the storage: %w", err)
+	}
+	if len(buf)%16 != 0 {
+		return fmt.Errorf("couldn't read the trace IDs from the storage: invalid length %d", len(buf))
+	}
+
+	st.Lock()
+	defer st.Unlock()
+	for i := 0; i < len(buf); i += 16 {
+		var id [16]byte
+		copy(id[:], buf[i:i+16])
+		traceID := pcommon.NewTraceID(id)
+		st.traceIDs[traceID] = struct{}{}
+		st.recoveredIDs = append(st.recoveredIDs, traceID)
+	}
+	return nil
+}
+
+func (st *diskStorage) recovered() []pcommon.TraceID {
+	st.Lock()
+	defer st.Unlock()
+	ids := st.recoveredIDs
+	st.recoveredIDs = nil
+	return ids
+}
+
+func (st *diskStorage) createOrAppend(traceID pcommon.TraceID, td ptrace.Traces) error {
+	st.Lock()
+	defer st.Unlock()
+
+	key := traceKey(traceID)
+	content, err := st.read(key)
+	if err != nil {
+		return err
+	}
+	td.ResourceSpans().MoveAndAppendTo(content.ResourceSpans())
+
+	buf, err := tracesMarshaler.MarshalTraces(content)
+	if err != nil {
+		return err
+	}
+	if _, ok := st.traceIDs[traceID]; ok {
+		return st.client.Set(context.Background(), key, buf)
+	}
+
+	// the trace IDs are persisted with the first spans of a trace, so that its key is never left out of the index
+	st.traceIDs[traceID] = struct{}{}
+	err = st.client.Batch(context.Background(),
+		extstorage.SetOperation(key, buf),
+		extstorage.SetOperation(indexKey, st.marshalIndex()),
+	)
+	if err != nil {
+		delete(st.traceIDs, traceID)
+		return err
+	}
+	st.indexDirty = false
+	return nil
+}
+
+func (st *diskStorage) get(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	if _, ok := st.traceIDs[traceID]; !ok {
+		return nil, nil
+	}
+	content, err := st.read(traceKey(traceID))
+	if err != nil {
+		return nil, err
+	}
+	return toResourceSpans(content), nil
+}
+
+func (st *diskStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	if _, ok := st.traceIDs[traceID]; !ok {
+		return nil, nil
+	}
+	key := traceKey(traceID)
+	content, err := st.read(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.client.Delete(context.Background(), key); err != nil {
+		return nil, err
+	}
+	delete(st.traceIDs, traceID)
+	st.indexDirty = true
+
+	return toResourceSpans(content), nil
+}
+
+func (st *diskStorage) shutdown() error {
+	close(st.stopCh)
+	st.wg.Wait()
+
+	if st.client == nil {
+		return nil
+	}
+	if err := st.flushIndex(); err != nil {
+		return err
+	}
+	return st.client.Close(context.Background())
+}
+
+// read returns the stored spans for the given key, or empty traces if there's nothing stored yet
+func (st *diskStorage) read(key string) (ptrace.Traces, error) {
+	buf, err := st.client.Get(context.Background(), key)
+	if err != nil {
+		return ptrace.Traces{}, err
+	}
+	if buf == nil {
+		return ptrace.NewTraces(), nil
+	}
+	return tracesUnmarshaler.UnmarshalTraces(buf)
+}
+
+// periodicFlush persists the trace IDs when traces were removed and records the number of stored traces
+func (st *diskStorage) periodicFlush() {
+	defer st.wg.Done()
+	ticker := time.NewTicker(st.tickerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := st.flushIndex(); err != nil {
+				st.logger.Warn("couldn't persist the trace IDs", zap.Error(err))
+			}
+			stats.Record(context.Background(), mNumTracesOnDisk.M(int64(st.count())))
+		case <-st.stopCh:
+			return
+		}
+	}
+}
+
+func (st *diskStorage) flushIndex() error {
+	st.Lock()
+	defer st.Unlock()
+
+	if !st.indexDirty {
+		return nil
+	}
+	if err := st.client.Set(context.Background(), indexKey, st.marshalIndex()); err != nil {
+		return err
+	}
+	st.indexDirty = false
+	return nil
+}
+
+// marshalIndex serializes the trace IDs, the caller must hold the lock
+func (st *diskStorage) marshalIndex() []byte {
+	buf := make([]byte, 0, len(st.traceIDs)*16)
+	for traceID := range st.traceIDs {
+		id := traceID.Bytes()
+		buf = append(buf, id[:]...)
+	}
+	return buf
+}
+
+func (st *diskStorage) count() int {
+	st.Lock()
+	defer st.Unlock()
+	return len(st.traceIDs)
+}
+
+func traceKey(traceID pcommon.TraceID) string {
+	return traceKeyPrefix + traceID.HexString()
+}
+
+func toResourceSpans(td ptrace.Traces) []ptrace.ResourceSpans {
+	var result []ptrace.ResourceSpans
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		result = append(result, td.ResourceSpans().At(i))
+	}
+	return result
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbytraceprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+// the storagetest package names the extensions with the "nop" type
+var testStorageID = config.NewComponentIDWithName("nop", "test")
+
+func newTestDiskStorage(t *testing.T, host component.Host) *diskStorage {
+	st := newDiskStorage(zap.NewNop(), testStorageID, config.NewComponentID(typeStr))
+	require.NoError(t, st.start(context.Background(), host))
+	return st
+}
+
+func TestDiskCreateAndGetTrace(t *testing.T) {
+	// prepare
+	st := newTestDiskStorage(t, storagetest.NewStorageHost(t, t.TempDir(), "test"))
+	defer func() { assert.NoError(t, st.shutdown()) }()
+
+	traceIDs := []pcommon.TraceID{
+		pcommon.NewTraceID([16]byte{1, 2, 3, 4}),
+		pcommon.NewTraceID([16]byte{2, 3, 4, 5}),
+	}
+
+	// test
+	for _, traceID := range traceIDs {
+		assert.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+	}
+
+	// verify
+	assert.Equal(t, 2, st.count())
+	for _, traceID := range traceIDs {
+		expected := simpleTracesWithID(traceID).ResourceSpans().At(0)
+
+		retrieved, err := st.get(traceID)
+		require.NoError(t, err)
+		assert.Equal(t, []ptrace.ResourceSpans{expected}, retrieved)
+	}
+}
+
+func TestDiskGetUnknownTrace(t *testing.T) {
+	st := newTestDiskStorage(t, storagetest.NewStorageHost(t, t.TempDir(), "test"))
+	defer func() { assert.NoError(t, st.shutdown()) }()
+
+	retrieved, err := st.get(pcommon.NewTraceID([16]byte{1, 2, 3, 4}))
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestDiskAppendAndDeleteTrace(t *testing.T) {
+	// prepare
+	st := newTestDiskStorage(t, storagetest.NewStorageHost(t, t.TempDir(), "test"))
+	defer func() { assert.NoError(t, st.shutdown()) }()
+
+	traceID := pcommon.NewTraceID([16]byte{1, 2, 3, 4})
+	first := simpleTracesWithID(traceID)
+	second := simpleTracesWithID(traceID)
+	second.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName("second-name")
+	expected := []ptrace.ResourceSpans{ptrace.NewResourceSpans(), ptrace.NewResourceSpans()}
+	first.ResourceSpans().At(0).CopyTo(expected[0])
+	second.ResourceSpans().At(0).CopyTo(expected[1])
+
+	require.NoError(t, st.createOrAppend(traceID, first))
+	require.NoError(t, st.createOrAppend(traceID, second))
+
+	// test
+	deleted, err := st.delete(traceID)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, expected, deleted)
+	assert.Equal(t, 0, st.count())
+
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestDiskRecoverTraces(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	st := newTestDiskStorage(t, storagetest.NewStorageHost(t, dir, "test"))
+	assert.Empty(t, st.recovered())
+
+	traceID := pcommon.NewTraceID([16]byte{1, 2, 3, 4})
+	require.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+	require.NoError(t, st.shutdown())
+
+	// test
+	st = newTestDiskStorage(t, storagetest.NewStorageHost(t, dir, "test"))
+	defer func() { assert.NoError(t, st.shutdown()) }()
+
+	// verify
+	assert.Equal(t, []pcommon.TraceID{traceID}, st.recovered())
+	assert.Empty(t, st.recovered(), "traces are recovered only once")
+
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	assert.Equal(t, []ptrace.ResourceSpans{simpleTracesWithID(traceID).ResourceSpans().At(0)}, retrieved)
+}
+
+func TestDiskRecoverTracesAfterCrash(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	st := newDiskStorage(zap.NewNop(), testStorageID, config.NewComponentID(typeStr))
+	st.tickerInterval = time.Hour
+	require.NoError(t, st.start(context.Background(), storagetest.NewStorageHost(t, dir, "test")))
+
+	traceID := pcommon.NewTraceID([16]byte{1, 2, 3, 4})
+	require.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+
+	// the storage is closed without flushing the trace IDs, as in a crash
+	close(st.stopCh)
+	st.wg.Wait()
+	require.NoError(t, st.client.Close(context.Background()))
+
+	// test
+	st = newTestDiskStorage(t, storagetest.NewStorageHost(t, dir, "test"))
+	defer func() { assert.NoError(t, st.shutdown()) }()
+
+	// verify
+	assert.Equal(t, []pcommon.TraceID{traceID}, st.recovered())
+
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	assert.Equal(t, []ptrace.ResourceSpans{simpleTracesWithID(traceID).ResourceSpans().At(0)}, retrieved)
+}
+
+func TestDiskStorageExtensionNotFound(t *testing.T) {
+	st := newDiskStorage(zap.NewNop(), testStorageID, config.NewComponentID(typeStr))
+	err := st.start(context.Background(), componenttest.NewNopHost())
+	assert.ErrorIs(t, err, errStorageExtensionNotFound)
+	assert.NoError(t, st.shutdown())
+}
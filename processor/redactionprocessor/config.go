@@ -21,23 +21,24 @@ import (
 type Config struct {
 	config.ProcessorSettings `mapstructure:",squash"`
 
-	// AllowAllKeys is a flag to allow all span attribute keys. Setting this
+	// AllowAllKeys is a flag to allow all attribute keys. Setting this
 	// to true disables the AllowedKeys list. The list of BlockedValues is
 	// applied regardless. If you just want to block values, set this to true.
 	AllowAllKeys bool `mapstructure:"allow_all_keys"`
 
-	// AllowedKeys is a list of allowed span attribute keys. Span attributes
-	// not on the list are removed. The list fails closed if it's empty. To
-	// allow all keys, you should explicitly set AllowAllKeys
+	// AllowedKeys is a list of allowed span, log record and data point
+	// attribute keys. Attributes not on the list are removed. The list fails
+	// closed if it's empty. To allow all keys, you should explicitly set
+	// AllowAllKeys
 	AllowedKeys []string `mapstructure:"allowed_keys"`
 
 	// BlockedValues is a list of regular expressions for blocking values of
-	// allowed span attributes. Values that match are masked
+	// allowed attributes and log record bodies. Values that match are masked
 	BlockedValues []string `mapstructure:"blocked_values"`
 
 	// Summary controls the verbosity level of the diagnostic attributes that
-	// the processor adds to the spans when it redacts or masks other
-	// attributes. In some contexts a list of redacted attributes leaks
+	// the processor adds to the spans, log records and data points when it
+	// redacts or masks other attributes. In some contexts a list of redacted attributes leaks
 	// information, while it is valuable when integrating and testing a new
 	// configuration. Possible values are `debug`, `info`, and `silent`.
 	Summary string `mapstructure:"summary"`
@@ -24,27 +24,25 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
 
-var _ component.TracesProcessor = (*redaction)(nil)
-
 type redaction struct {
-	// Attribute keys allowed in a span
+	// Attribute keys allowed in a span, log record or data point
 	allowList map[string]string
-	// Attribute values blocked in a span
+	// Attribute values blocked in a span, log record or data point
 	blockRegexList map[string]*regexp.Regexp
 	// Redaction processor configuration
 	config *Config
 	// Logger
 	logger *zap.Logger
-	// Next trace consumer in line
-	next consumer.Traces
 }
 
 // newRedaction creates a new instance of the redaction processor
-func newRedaction(ctx context.Context, config *Config, logger *zap.Logger, next consumer.Traces) (*redaction, error) {
+func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*redaction, error) {
 	allowList := makeAllowList(config)
 	blockRegexList, err := makeBlockRegexList(ctx, config)
 	if err != nil {
@@ -57,11 +55,10 @@ func newRedaction(ctx context.Context, config *Config, logger *zap.Logger, next
 		blockRegexList: blockRegexList,
 		config:         config,
 		logger:         logger,
-		next:           next,
 	}, nil
 }
 
-// processTraces implements ProcessMetricsFunc. It processes the incoming data
+// processTraces implements ProcessTracesFunc. It processes the incoming data
 // and returns the data to be sent to the next component
 func (s *redaction) processTraces(ctx context.Context, batch ptrace.Traces) (ptrace.Traces, error) {
 	for i := 0; i < batch.ResourceSpans().Len(); i++ {
@@ -91,8 +88,139 @@ func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceS
 	}
 }
 
-// processAttrs redacts the attributes of a resource span or a span
-func (s *redaction) processAttrs(_ context.Context, attributes *pcommon.Map) {
+// processLogs implements ProcessLogsFunc. It processes the incoming data
+// and returns the data to be sent to the next component
+func (s *redaction) processLogs(ctx context.Context, batch plog.Logs) (plog.Logs, error) {
+	for i := 0; i < batch.ResourceLogs().Len(); i++ {
+		rl := batch.ResourceLogs().At(i)
+		s.processResourceLog(ctx, rl)
+	}
+	return batch, nil
+}
+
+// processResourceLog processes the RL and all of its log records
+func (s *redaction) processResourceLog(ctx context.Context, rl plog.ResourceLogs) {
+	rlAttrs := rl.Resource().Attributes()
+
+	// Attributes can be part of a resource log
+	s.processAttrs(ctx, &rlAttrs)
+
+	for j := 0; j < rl.ScopeLogs().Len(); j++ {
+		sl := rl.ScopeLogs().At(j)
+		for k := 0; k < sl.LogRecords().Len(); k++ {
+			logRecord := sl.LogRecords().At(k)
+			logAttrs := logRecord.Attributes()
+
+			// Attributes can also be part of a log record, and sensitive
+			// data can be part of its body
+			toDelete, toBlock := s.redactAttrs(ctx, &logAttrs)
+			bodyDeleted, bodyBlocked := s.redactBody(ctx, logRecord.Body())
+			s.summarizeRedactedSpan(append(toDelete, bodyDeleted...), &logAttrs)
+			s.summarizeMaskedSpan(append(toBlock, bodyBlocked...), &logAttrs)
+		}
+	}
+}
+
+// redactBody redacts the body of a log record. The keys of map bodies are
+// redacted like attributes, while other bodies are masked. The redacted
+// and masked keys of the body are returned with a "body." prefix
+func (s *redaction) redactBody(ctx context.Context, body pcommon.Value) ([]string, []string) {
+	switch body.Type() {
+	case pcommon.ValueTypeMap:
+		bodyMap := body.MapVal()
+		toDelete, toBlock := s.redactAttrs(ctx, &bodyMap)
+		return prefixKeys("body.", toDelete), prefixKeys("body.", toBlock)
+	case pcommon.ValueTypeString, pcommon.ValueTypeSlice:
+		if s.maskValue(body) {
+			return nil, []string{"body"}
+		}
+	}
+	return nil, nil
+}
+
+// prefixKeys adds a prefix to every key of the list
+func prefixKeys(prefix string, keys []string) []string {
+	for i, k := range keys {
+		keys[i] = prefix + k
+	}
+	return keys
+}
+
+// processMetrics implements ProcessMetricsFunc. It processes the incoming data
+// and returns the data to be sent to the next component
+func (s *redaction) processMetrics(ctx context.Context, batch pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < batch.ResourceMetrics().Len(); i++ {
+		rm := batch.ResourceMetrics().At(i)
+		s.processResourceMetric(ctx, rm)
+	}
+	return batch, nil
+}
+
+// processResourceMetric processes the RM and all of its data points
+func (s *redaction) processResourceMetric(ctx context.Context, rm pmetric.ResourceMetrics) {
+	rmAttrs := rm.Resource().Attributes()
+
+	// Attributes can be part of a resource metric
+	s.processAttrs(ctx, &rmAttrs)
+
+	for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+		sm := rm.ScopeMetrics().At(j)
+		for k := 0; k < sm.Metrics().Len(); k++ {
+			// Attributes can also be part of a data point
+			s.processDataPoints(ctx, sm.Metrics().At(k))
+		}
+	}
+}
+
+// processDataPoints redacts the attributes of all the data points of a metric
+func (s *redaction) processDataPoints(ctx context.Context, metric pmetric.Metric) {
+	switch metric.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dpAttrs := dps.At(i).Attributes()
+			s.processAttrs(ctx, &dpAttrs)
+		}
+	case pmetric.MetricDataTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dpAttrs := dps.At(i).Attributes()
+			s.processAttrs(ctx, &dpAttrs)
+		}
+	case pmetric.MetricDataTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dpAttrs := dps.At(i).Attributes()
+			s.processAttrs(ctx, &dpAttrs)
+		}
+	case pmetric.MetricDataTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dpAttrs := dps.At(i).Attributes()
+			s.processAttrs(ctx, &dpAttrs)
+		}
+	case pmetric.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dpAttrs := dps.At(i).Attributes()
+			s.processAttrs(ctx, &dpAttrs)
+		}
+	}
+}
+
+// processAttrs redacts the attributes of a resource, a span, a log record or
+// a data point, and adds the summary of the changes to the attributes
+func (s *redaction) processAttrs(ctx context.Context, attributes *pcommon.Map) {
+	toDelete, toBlock := s.redactAttrs(ctx, attributes)
+
+	// Add diagnostic information to the span
+	s.summarizeRedactedSpan(toDelete, attributes)
+	s.summarizeMaskedSpan(toBlock, attributes)
+}
+
+// redactAttrs redacts the attributes and returns the keys of the redacted
+// and masked attributes
+func (s *redaction) redactAttrs(_ context.Context, attributes *pcommon.Map) ([]string, []string) {
 	// TODO: Use the context for recording metrics
 	var toDelete []string
 	var toBlock []string
@@ -116,15 +244,8 @@ func (s *redaction) processAttrs(_ context.Context, attributes *pcommon.Map) {
 		}
 
 		// Mask any blocked values for the other attributes
-		for _, compiledRE := range s.blockRegexList {
-			match := compiledRE.MatchString(value.StringVal())
-			if match {
-				toBlock = append(toBlock, k)
-
-				valueCopy := value.StringVal()
-				maskedValue := compiledRE.ReplaceAllString(valueCopy, "****")
-				attributes.Update(k, pcommon.NewValueString(maskedValue))
-			}
+		if s.maskValue(value) {
+			toBlock = append(toBlock, k)
 		}
 		return true
 	})
@@ -133,20 +254,38 @@ func (s *redaction) processAttrs(_ context.Context, attributes *pcommon.Map) {
 	for _, k := range toDelete {
 		attributes.Remove(k)
 	}
-	// Add diagnostic information to the span
-	s.summarizeRedactedSpan(toDelete, attributes)
-	s.summarizeMaskedSpan(toBlock, attributes)
+	return toDelete, toBlock
 }
 
-// ConsumeTraces implements the SpanProcessor interface
-func (s *redaction) ConsumeTraces(ctx context.Context, batch ptrace.Traces) error {
-	batch, err := s.processTraces(ctx, batch)
-	if err != nil {
-		return err
+// maskValue masks the parts of a string value matching the blocked values.
+// The values nested in maps and slices are masked as well. It returns true
+// if any part of the value was masked
+func (s *redaction) maskValue(value pcommon.Value) bool {
+	masked := false
+	switch value.Type() {
+	case pcommon.ValueTypeString:
+		for _, compiledRE := range s.blockRegexList {
+			if compiledRE.MatchString(value.StringVal()) {
+				masked = true
+				value.SetStringVal(compiledRE.ReplaceAllString(value.StringVal(), "****"))
+			}
+		}
+	case pcommon.ValueTypeMap:
+		value.MapVal().Range(func(_ string, nested pcommon.Value) bool {
+			if s.maskValue(nested) {
+				masked = true
+			}
+			return true
+		})
+	case pcommon.ValueTypeSlice:
+		slice := value.SliceVal()
+		for i := 0; i < slice.Len(); i++ {
+			if s.maskValue(slice.At(i)) {
+				masked = true
+			}
+		}
 	}
-
-	err = s.next.ConsumeTraces(ctx, batch)
-	return err
+	return masked
 }
 
 // summarizeRedactedSpan adds diagnostic information about redacted attribute keys
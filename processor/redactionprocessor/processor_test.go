@@ -24,14 +24,15 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap/zaptest"
 )
 
 func TestCapabilities(t *testing.T) {
 	config := &Config{}
-	next := new(consumertest.TracesSink)
-	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t), next)
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
 	assert.NoError(t, err)
 
 	cap := processor.Capabilities()
@@ -40,8 +41,7 @@ func TestCapabilities(t *testing.T) {
 
 func TestStartShutdown(t *testing.T) {
 	config := &Config{}
-	next := new(consumertest.TracesSink)
-	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t), next)
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
 	assert.NoError(t, err)
 
 	ctx := context.Background()
@@ -144,8 +144,6 @@ func TestAllowAllKeysMaskValues(t *testing.T) {
 	assert.Equal(t, "placeholder ****", value.StringVal())
 }
 
-// TODO: Test redaction with metric tags in a metrics PR
-
 // TestRedactSummaryDebug validates that the processor writes a verbose summary
 // of any attributes it deleted to the new redaction.redacted.keys and
 // redaction.redacted.count span attributes while set to full debug output
@@ -350,6 +348,204 @@ func TestMultipleBlockValues(t *testing.T) {
 	assert.Equal(t, "mystery ****", mysteryValue.StringVal())
 }
 
+// TestRedactLogs validates that the processor redacts and masks the
+// attributes of log records, and masks their string bodies
+// TestMaskNestedValues validates that blocked values nested in map and slice
+// span attributes are masked
+func TestMaskNestedValues(t *testing.T) {
+	config := &Config{AllowedKeys: []string{"user", "cards", "id"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "debug"}
+	user := pcommon.NewValueMap()
+	user.MapVal().InsertString("name", "placeholder")
+	user.MapVal().InsertString("card", "4111111111111111")
+	cards := pcommon.NewValueSlice()
+	cards.SliceVal().AppendEmpty().SetStringVal("4111111111111111")
+	cards.SliceVal().AppendEmpty().SetStringVal("none")
+	allowed := map[string]pcommon.Value{
+		"id": pcommon.NewValueInt(5),
+	}
+	masked := map[string]pcommon.Value{
+		"user":  user,
+		"cards": cards,
+	}
+
+	_, _, next := runTest(t, allowed, nil, masked, config)
+
+	attr := next.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+	value, _ := attr.Get("user")
+	nested, _ := value.MapVal().Get("name")
+	assert.Equal(t, "placeholder", nested.StringVal())
+	nested, _ = value.MapVal().Get("card")
+	assert.Equal(t, "****", nested.StringVal())
+	value, _ = attr.Get("cards")
+	assert.Equal(t, "****", value.SliceVal().At(0).StringVal())
+	assert.Equal(t, "none", value.SliceVal().At(1).StringVal())
+	value, _ = attr.Get(maskedValues)
+	assert.Equal(t, "cards,user", value.StringVal())
+	value, _ = attr.Get(maskedValueCount)
+	assert.Equal(t, int64(2), value.IntVal())
+}
+
+func TestRedactLogs(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"id", "name"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "debug",
+	}
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	inBatch := plog.NewLogs()
+	rl := inBatch.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("id", "resource 4111111111111111")
+	logRecord := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	logRecord.Body().SetStringVal("payment with 4111111111111111 failed")
+	logRecord.Attributes().InsertInt("id", 5)
+	logRecord.Attributes().InsertString("name", "placeholder 4111111111111111")
+	logRecord.Attributes().InsertString("credit_card", "4111111111111111")
+
+	outBatch, err := processor.processLogs(context.Background(), inBatch)
+	assert.NoError(t, err)
+
+	resourceAttrs := outBatch.ResourceLogs().At(0).Resource().Attributes()
+	value, _ := resourceAttrs.Get("id")
+	assert.Equal(t, "resource ****", value.StringVal())
+
+	outLog := outBatch.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "payment with **** failed", outLog.Body().StringVal())
+	attr := outLog.Attributes()
+	_, ok := attr.Get("credit_card")
+	assert.False(t, ok)
+	value, _ = attr.Get("id")
+	assert.Equal(t, int64(5), value.IntVal())
+	value, _ = attr.Get("name")
+	assert.Equal(t, "placeholder ****", value.StringVal())
+	value, _ = attr.Get(redactedKeys)
+	assert.Equal(t, "credit_card", value.StringVal())
+	value, _ = attr.Get(maskedValues)
+	assert.Equal(t, "body,name", value.StringVal())
+	value, _ = attr.Get(maskedValueCount)
+	assert.Equal(t, int64(2), value.IntVal())
+}
+
+// TestRedactLogsMapBody validates that the processor redacts the keys of map
+// bodies, and masks the values nested in them
+func TestRedactLogsMapBody(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"user", "message"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "debug",
+	}
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	inBatch := plog.NewLogs()
+	logRecord := inBatch.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	body := pcommon.NewValueMap()
+	body.MapVal().InsertString("message", "paid with 4111111111111111")
+	body.MapVal().InsertString("credit_card", "4111111111111111")
+	user := pcommon.NewValueMap()
+	user.MapVal().InsertString("name", "placeholder")
+	user.MapVal().InsertString("card", "4111111111111111")
+	body.MapVal().Insert("user", user)
+	body.CopyTo(logRecord.Body())
+
+	outBatch, err := processor.processLogs(context.Background(), inBatch)
+	assert.NoError(t, err)
+
+	outLog := outBatch.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	outBody := outLog.Body().MapVal()
+	_, ok := outBody.Get("credit_card")
+	assert.False(t, ok)
+	value, _ := outBody.Get("message")
+	assert.Equal(t, "paid with ****", value.StringVal())
+	value, _ = outBody.Get("user")
+	nested, _ := value.MapVal().Get("name")
+	assert.Equal(t, "placeholder", nested.StringVal())
+	nested, _ = value.MapVal().Get("card")
+	assert.Equal(t, "****", nested.StringVal())
+
+	attr := outLog.Attributes()
+	value, _ = attr.Get(redactedKeys)
+	assert.Equal(t, "body.credit_card", value.StringVal())
+	value, _ = attr.Get(maskedValues)
+	assert.Equal(t, "body.message,body.user", value.StringVal())
+}
+
+// TestRedactMetrics validates that the processor redacts and masks the
+// attributes of data points
+// TestRedactLogsMapBodyNotAllowed validates that a map body is left empty
+// when none of its keys are allowed
+func TestRedactLogsMapBodyNotAllowed(t *testing.T) {
+	config := &Config{
+		AllowedKeys: []string{"user"},
+		Summary:     "debug",
+	}
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	inBatch := plog.NewLogs()
+	logRecord := inBatch.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	body := pcommon.NewValueMap()
+	body.MapVal().InsertString("message", "user logged in")
+	body.MapVal().InsertString("level", "info")
+	body.CopyTo(logRecord.Body())
+
+	outBatch, err := processor.processLogs(context.Background(), inBatch)
+	assert.NoError(t, err)
+
+	outLog := outBatch.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, 0, outLog.Body().MapVal().Len())
+	value, _ := outLog.Attributes().Get(redactedKeys)
+	assert.Equal(t, "body.level,body.message", value.StringVal())
+}
+
+func TestRedactMetrics(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"id", "name"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "info",
+	}
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	inBatch := pmetric.NewMetrics()
+	metrics := inBatch.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty()
+	gauge.SetDataType(pmetric.MetricDataTypeGauge)
+	histogram := metrics.AppendEmpty()
+	histogram.SetDataType(pmetric.MetricDataTypeHistogram)
+	for _, attrs := range []pcommon.Map{
+		gauge.Gauge().DataPoints().AppendEmpty().Attributes(),
+		histogram.Histogram().DataPoints().AppendEmpty().Attributes(),
+	} {
+		attrs.InsertInt("id", 5)
+		attrs.InsertString("name", "placeholder 4111111111111111")
+		attrs.InsertString("credit_card", "4111111111111111")
+	}
+
+	outBatch, err := processor.processMetrics(context.Background(), inBatch)
+	assert.NoError(t, err)
+
+	outMetrics := outBatch.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for _, attr := range []pcommon.Map{
+		outMetrics.At(0).Gauge().DataPoints().At(0).Attributes(),
+		outMetrics.At(1).Histogram().DataPoints().At(0).Attributes(),
+	} {
+		_, ok := attr.Get("credit_card")
+		assert.False(t, ok)
+		value, _ := attr.Get("id")
+		assert.Equal(t, int64(5), value.IntVal())
+		value, _ = attr.Get("name")
+		assert.Equal(t, "placeholder ****", value.StringVal())
+		value, _ = attr.Get(redactedKeyCount)
+		assert.Equal(t, int64(1), value.IntVal())
+		value, _ = attr.Get(maskedValueCount)
+		assert.Equal(t, int64(1), value.IntVal())
+	}
+}
+
 // runTest transforms the test input data and passes it through the processor
 func runTest(
 	t *testing.T,
@@ -386,9 +582,11 @@ func runTest(
 	// test
 	ctx := context.Background()
 	next := new(consumertest.TracesSink)
-	processor, err := newRedaction(ctx, config, zaptest.NewLogger(t), next)
+	processor, err := newRedaction(ctx, config, zaptest.NewLogger(t))
 	assert.NoError(t, err)
-	err = processor.ConsumeTraces(ctx, inBatch)
+	outBatch, err := processor.processTraces(ctx, inBatch)
+	assert.NoError(t, err)
+	err = next.ConsumeTraces(ctx, outBatch)
 
 	// verify
 	assert.NoError(t, err)
@@ -417,8 +615,7 @@ func BenchmarkRedactSummaryDebug(b *testing.B) {
 		"credit_card": pcommon.NewValueString("would be nice"),
 	}
 	ctx := context.Background()
-	next := new(consumertest.TracesSink)
-	processor, _ := newRedaction(ctx, config, zaptest.NewLogger(b), next)
+	processor, _ := newRedaction(ctx, config, zaptest.NewLogger(b))
 
 	for i := 0; i < b.N; i++ {
 		runBenchmark(allowed, redacted, masked, processor)
@@ -444,8 +641,7 @@ func BenchmarkMaskSummaryDebug(b *testing.B) {
 		"url":  pcommon.NewValueString("https://www.this_is_testing_url.com"),
 	}
 	ctx := context.Background()
-	next := new(consumertest.TracesSink)
-	processor, _ := newRedaction(ctx, config, zaptest.NewLogger(b), next)
+	processor, _ := newRedaction(ctx, config, zaptest.NewLogger(b))
 
 	for i := 0; i < b.N; i++ {
 		runBenchmark(allowed, nil, masked, processor)
@@ -479,5 +675,5 @@ func runBenchmark(
 		span.Attributes().Upsert(k, v)
 	}
 
-	_ = processor.ConsumeTraces(context.Background(), inBatch)
+	_, _ = processor.processTraces(context.Background(), inBatch)
 }
@@ -109,6 +109,34 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			configPath: "config_statements.yaml",
+			factoriesFunc: func(factories component.Factories) component.Factories {
+				// we don't need to use them in this test, but the config has them
+				factories.Exporters["otlp"] = otlpexporter.NewFactory()
+				factories.Exporters["jaeger"] = jaegerexporter.NewFactory()
+				return factories
+			},
+			expectedConfig: &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				DefaultExporters:  []string{"otlp"},
+				AttributeSource:   "context",
+				Table: []RoutingTableItem{
+					{
+						Statement: `route() where resource.attributes["X-Tenant"] == "acme" and IsMatch(resource.attributes["service.name"], "^checkout-.*") == true`,
+						Exporters: []string{"jaeger/acme", "otlp/acme"},
+					},
+					{
+						Statement: `route() where resource.attributes["X-Tenant"] == "acme"`,
+						Exporters: []string{"otlp/acme"},
+					},
+					{
+						Statement: `route() where resource.attributes["X-Tenant"] == "globex" or resource.attributes["region"] == "eu"`,
+						Exporters: []string{"otlp/globex"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -129,3 +157,88 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		error  string
+	}{
+		{
+			name: "value and statement in the same route",
+			config: &Config{
+				FromAttribute: "X-Tenant",
+				Table: []RoutingTableItem{
+					{
+						Value:     "acme",
+						Statement: `route() where resource.attributes["X-Tenant"] == "acme"`,
+						Exporters: []string{"otlp"},
+					},
+				},
+			},
+			error: errValueAndStatement.Error(),
+		},
+		{
+			name: "value and statement routes",
+			config: &Config{
+				FromAttribute: "X-Tenant",
+				Table: []RoutingTableItem{
+					{
+						Value:     "acme",
+						Exporters: []string{"otlp"},
+					},
+					{
+						Statement: `route() where resource.attributes["X-Tenant"] == "globex"`,
+						Exporters: []string{"otlp"},
+					},
+				},
+			},
+			error: errMixedRoutes.Error(),
+		},
+		{
+			name: "statement without route()",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Statement: `set(attributes["X-Tenant"], "acme")`,
+						Exporters: []string{"otlp"},
+					},
+				},
+			},
+			error: errNotRouteStatement.Error(),
+		},
+		{
+			name: "invalid statement",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Statement: `route() where attributes["X-Tenant"] == "acme"`,
+						Exporters: []string{"otlp"},
+					},
+				},
+			},
+			error: "routing statements can only use resource paths",
+		},
+		{
+			name: "statement with drop_resource_routing_attribute",
+			config: &Config{
+				AttributeSource:              resourceAttributeSource,
+				DropRoutingResourceAttribute: true,
+				Table: []RoutingTableItem{
+					{
+						Statement: `route() where resource.attributes["X-Tenant"] == "acme"`,
+						Exporters: []string{"otlp"},
+					},
+				},
+			},
+			error: "drop_resource_routing_attribute cannot be used with statements",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.error)
+		})
+	}
+}
@@ -32,6 +32,8 @@ var (
 	errNoExporters                  = errors.New("no exporters defined for the route")
 	errNoTableItems                 = errors.New("the routing table is empty")
 	errNoMissingFromAttribute       = errors.New("the FromAttribute property is empty")
+	errValueAndStatement            = errors.New("both a value and a statement are defined for the route")
+	errMixedRoutes                  = errors.New("routes with values and routes with statements cannot be mixed")
 	errDefaultExporterNotFound      = errors.New("default exporter not found")
 	errExporterNotFound             = errors.New("exporter not found")
 	errNoExportersAfterRegistration = errors.New("provided configuration resulted in no exporter available to accept data")
@@ -61,6 +63,9 @@ func newProcessor(logger *zap.Logger, cfg config.Processor) *processorImp {
 }
 
 func (e *processorImp) Start(_ context.Context, host component.Host) error {
+	if err := e.router.parseRoutes(); err != nil {
+		return err
+	}
 	return e.router.registerExporters(host.GetExporters())
 }
 
@@ -875,6 +875,168 @@ func TestLogs_AreCorrectlySplitPerResourceAttributeRouting(t *testing.T) {
 	)
 }
 
+func TestTraces_RoutingWorks_Statements(t *testing.T) {
+	defaultExp := &mockTracesExporter{}
+	firstExp := &mockTracesExporter{}
+	secondExp := &mockTracesExporter{}
+
+	host := &mockHost{
+		Host: componenttest.NewNopHost(),
+		GetExportersFunc: func() map[config.DataType]map[config.ComponentID]component.Exporter {
+			return map[config.DataType]map[config.ComponentID]component.Exporter{
+				config.TracesDataType: {
+					config.NewComponentID("otlp"):   defaultExp,
+					config.NewComponentID("otlp/1"): firstExp,
+					config.NewComponentID("otlp/2"): secondExp,
+				},
+			}
+		},
+	}
+
+	exp := newProcessor(zap.NewNop(), &Config{
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Statement: `route() where resource.attributes["X-Tenant"] == "acme" and IsMatch(resource.attributes["service.name"], "^checkout-.*") == true`,
+				Exporters: []string{"otlp/1"},
+			},
+			{
+				Statement: `route() where resource.attributes["X-Tenant"] == "acme"`,
+				Exporters: []string{"otlp/2"},
+			},
+		},
+	})
+
+	tr := ptrace.NewTraces()
+
+	rs := tr.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("X-Tenant", "acme")
+	rs.Resource().Attributes().InsertString("service.name", "checkout-api")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+
+	rs = tr.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("X-Tenant", "acme")
+	rs.Resource().Attributes().InsertString("service.name", "cart")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span1")
+
+	rs = tr.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("X-Tenant", "acme")
+	rs.Resource().Attributes().InsertString("service.name", "checkout-worker")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span2")
+
+	rs = tr.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("X-Tenant", "globex")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span3")
+
+	ctx := context.Background()
+	require.NoError(t, exp.Start(ctx, host))
+	require.NoError(t, exp.ConsumeTraces(ctx, tr))
+
+	// Routes are evaluated in order, so the resources matching both routes
+	// are only routed to the exporters of the first one.
+	require.Len(t, firstExp.AllTraces(), 1)
+	assert.Equal(t, 2, firstExp.AllTraces()[0].SpanCount())
+	require.Len(t, secondExp.AllTraces(), 1)
+	assert.Equal(t, "span1", secondExp.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	require.Len(t, defaultExp.AllTraces(), 1)
+	assert.Equal(t, "span3", defaultExp.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestMetrics_RoutingWorks_Statements(t *testing.T) {
+	defaultExp := &mockMetricsExporter{}
+	mExp := &mockMetricsExporter{}
+
+	host := &mockHost{
+		Host: componenttest.NewNopHost(),
+		GetExportersFunc: func() map[config.DataType]map[config.ComponentID]component.Exporter {
+			return map[config.DataType]map[config.ComponentID]component.Exporter{
+				config.MetricsDataType: {
+					config.NewComponentID("otlp"):   defaultExp,
+					config.NewComponentID("otlp/2"): mExp,
+				},
+			}
+		},
+	}
+
+	exp := newProcessor(zap.NewNop(), &Config{
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Statement: `route() where resource.attributes["X-Tenant"] == "acme" or resource.attributes["region"] == "eu"`,
+				Exporters: []string{"otlp/2"},
+			},
+		},
+	})
+
+	m := pmetric.NewMetrics()
+
+	rm := m.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("X-Tenant", "acme")
+	rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("cpu")
+
+	rm = m.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("region", "eu")
+	rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("cpu_system")
+
+	rm = m.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("X-Tenant", "something-else")
+	rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("cpu_idle")
+
+	ctx := context.Background()
+	require.NoError(t, exp.Start(ctx, host))
+	require.NoError(t, exp.ConsumeMetrics(ctx, m))
+
+	require.Len(t, mExp.AllMetrics(), 1)
+	assert.Equal(t, 2, mExp.AllMetrics()[0].MetricCount())
+	require.Len(t, defaultExp.AllMetrics(), 1)
+	assert.Equal(t, 1, defaultExp.AllMetrics()[0].MetricCount())
+}
+
+func TestLogs_RoutingWorks_Statements(t *testing.T) {
+	defaultExp := &mockLogsExporter{}
+	lExp := &mockLogsExporter{}
+
+	host := &mockHost{
+		Host: componenttest.NewNopHost(),
+		GetExportersFunc: func() map[config.DataType]map[config.ComponentID]component.Exporter {
+			return map[config.DataType]map[config.ComponentID]component.Exporter{
+				config.LogsDataType: {
+					config.NewComponentID("otlp"):   defaultExp,
+					config.NewComponentID("otlp/2"): lExp,
+				},
+			}
+		},
+	}
+
+	exp := newProcessor(zap.NewNop(), &Config{
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Statement: `route() where resource.attributes["X-Tenant"] != nil`,
+				Exporters: []string{"otlp/2"},
+			},
+		},
+	})
+
+	l := plog.NewLogs()
+
+	rl := l.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("X-Tenant", "acme")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	rl = l.ResourceLogs().AppendEmpty()
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	ctx := context.Background()
+	require.NoError(t, exp.Start(ctx, host))
+	require.NoError(t, exp.ConsumeLogs(ctx, l))
+
+	require.Len(t, lExp.AllLogs(), 1)
+	assert.Equal(t, 1, lExp.AllLogs()[0].LogRecordCount())
+	require.Len(t, defaultExp.AllLogs(), 1)
+	assert.Equal(t, 1, defaultExp.AllLogs()[0].LogRecordCount())
+}
+
 func Benchmark_MetricsRouting_ResourceAttribute(b *testing.B) {
 	cfg := &Config{
 		FromAttribute:    "X-Tenant",
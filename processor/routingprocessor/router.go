@@ -26,9 +26,12 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
-// router routes logs, metrics and traces using the configured attributes and
+// router routes logs, metrics and traces using the configured statements, or attributes and
 // attribute sources.
 // Upon routing it also groups the logs, metrics and spans into a joint upper level
 // structure (plog.Logs, pmetric.Metrics and ptrace.Traces respectively) in order
@@ -39,6 +42,9 @@ type router struct {
 	logger    *zap.Logger
 	extractor extractor
 
+	// routes contains the parsed statements of the routing table, in order
+	routes []statementRoute
+
 	defaultLogsExporters    []component.LogsExporter
 	logsExporters           map[string][]component.LogsExporter
 	defaultMetricsExporters []component.MetricsExporter
@@ -58,12 +64,55 @@ func newRouter(config Config, logger *zap.Logger) *router {
 	}
 }
 
+// statementRoute is a route of the routing table defined by a statement.
+type statementRoute struct {
+	statement string
+	query     tql.Query
+}
+
+// parseRoutes parses the statements of the routing table.
+func (r *router) parseRoutes() error {
+	r.routes = nil
+	for _, item := range r.config.Table {
+		if len(item.Statement) == 0 {
+			continue
+		}
+		query, err := parseRouteStatement(item.Statement)
+		if err != nil {
+			return fmt.Errorf("invalid route %s: %w", item.Statement, err)
+		}
+		r.routes = append(r.routes, statementRoute{statement: item.Statement, query: query})
+	}
+	return nil
+}
+
+// routeForResource returns the route of the given resource: the statement of the first route whose
+// condition matches the resource when the routing table uses statements, or the value of the
+// routing attribute otherwise.
+func (r *router) routeForResource(resource pcommon.Resource) string {
+	if len(r.routes) == 0 {
+		return r.extractor.extractAttrFromResource(resource)
+	}
+
+	ctx := tqlresource.NewTransformContext(resource)
+	for _, route := range r.routes {
+		if route.query.Condition(ctx) {
+			return route.statement
+		}
+	}
+	return ""
+}
+
 type routedMetrics struct {
 	metrics   pmetric.Metrics
 	exporters []component.MetricsExporter
 }
 
 func (r *router) RouteMetrics(ctx context.Context, tm pmetric.Metrics) []routedMetrics {
+	if len(r.routes) > 0 {
+		return r.routeMetricsForResource(ctx, tm)
+	}
+
 	switch r.config.AttributeSource {
 	case resourceAttributeSource:
 		return r.routeMetricsForResource(ctx, tm)
@@ -93,7 +142,7 @@ func (r *router) routeMetricsForResource(_ context.Context, tm pmetric.Metrics)
 	for i := 0; i < resMetricsSlice.Len(); i++ {
 		resMetrics := resMetricsSlice.At(i)
 
-		attrValue := r.extractor.extractAttrFromResource(resMetrics.Resource())
+		attrValue := r.routeForResource(resMetrics.Resource())
 		exp := r.defaultMetricsExporters
 		// If we have an exporter list defined for that attribute value then use it.
 		if e, ok := r.metricsExporters[attrValue]; ok {
@@ -156,6 +205,10 @@ type routedTraces struct {
 }
 
 func (r *router) RouteTraces(ctx context.Context, tr ptrace.Traces) []routedTraces {
+	if len(r.routes) > 0 {
+		return r.routeTracesForResource(ctx, tr)
+	}
+
 	switch r.config.AttributeSource {
 	case resourceAttributeSource:
 		return r.routeTracesForResource(ctx, tr)
@@ -181,7 +234,7 @@ func (r *router) routeTracesForResource(_ context.Context, tr ptrace.Traces) []r
 	for i := 0; i < resSpansSlice.Len(); i++ {
 		resSpans := resSpansSlice.At(i)
 
-		attrValue := r.extractor.extractAttrFromResource(resSpans.Resource())
+		attrValue := r.routeForResource(resSpans.Resource())
 		exp := r.defaultTracesExporters
 		// If we have an exporter list defined for that attribute value then use it.
 		if e, ok := r.tracesExporters[attrValue]; ok {
@@ -244,6 +297,10 @@ type routedLogs struct {
 }
 
 func (r *router) RouteLogs(ctx context.Context, tl plog.Logs) []routedLogs {
+	if len(r.routes) > 0 {
+		return r.routeLogsForResource(ctx, tl)
+	}
+
 	switch r.config.AttributeSource {
 	case resourceAttributeSource:
 		return r.routeLogsForResource(ctx, tl)
@@ -269,7 +326,7 @@ func (r *router) routeLogsForResource(_ context.Context, tl plog.Logs) []routedL
 	for i := 0; i < resLogsSlice.Len(); i++ {
 		resLogs := resLogsSlice.At(i)
 
-		attrValue := r.extractor.extractAttrFromResource(resLogs.Resource())
+		attrValue := r.routeForResource(resLogs.Resource())
 		exp := r.defaultLogsExporters
 		// If we have an exporter list defined for that attribute value then use it.
 		if e, ok := r.logsExporters[attrValue]; ok {
@@ -435,9 +492,9 @@ func (r *router) registerExportersForRoutes(available ExporterMap) error {
 		return err
 	}
 
-	// exporters for each defined value
+	// exporters for each defined value or statement
 	for _, item := range r.config.Table {
-		if err := r.registerExportersForRoute(item.Value, available, item.Exporters); err != nil {
+		if err := r.registerExportersForRoute(item.route(), available, item.Exporters); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor"
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlfunctions"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// functions are the functions available to routing statements: route, and the functions returning values,
+// which can be used in the conditions.
+var functions = tqlfunctions.Converters()
+
+func init() {
+	functions["route"] = route
+}
+
+// routeInvocation matches the invocation all routing statements start with.
+var routeInvocation = regexp.MustCompile(`^\s*route\s*\(\s*\)`)
+
+var (
+	errNotRouteStatement = errors.New("routing statements must invoke route()")
+	errNotResourcePath   = errors.New(`routing statements can only use resource paths, such as resource.attributes["tenant"]`)
+)
+
+// route does nothing: the data is routed by the processor when the condition of the statement matches.
+func route() (tql.ExprFunc, error) {
+	return func(ctx tql.TransformContext) interface{} {
+		return nil
+	}, nil
+}
+
+// parseRouteStatement parses a statement of the routing table, evaluated against resources.
+func parseRouteStatement(statement string) (tql.Query, error) {
+	if !routeInvocation.MatchString(statement) {
+		return tql.Query{}, errNotRouteStatement
+	}
+	queries, err := tql.ParseQueries([]string{statement}, functions, parseResourcePath)
+	if err != nil {
+		return tql.Query{}, err
+	}
+	return queries[0], nil
+}
+
+// parseResourcePath parses the paths of routing statements, which must refer to the resource,
+// such as `resource.attributes["tenant"]`.
+func parseResourcePath(val *tql.Path) (tql.GetSetter, error) {
+	if val == nil || len(val.Fields) < 2 || val.Fields[0].Name != "resource" {
+		return nil, errNotResourcePath
+	}
+	return tqlresource.ParsePath(&tql.Path{Fields: val.Fields[1:]})
+}
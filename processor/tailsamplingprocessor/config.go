@@ -48,6 +48,8 @@ const (
 	SpanCount PolicyType = "span_count"
 	// TraceState sample traces with specified values by the given key
 	TraceState PolicyType = "trace_state"
+	// TQLCondition sample traces with a span or span event matching any of the given TQL conditions
+	TQLCondition PolicyType = "tql_condition"
 )
 
 // SubPolicyCfg holds the common configuration to all policies under composite policy.
@@ -74,6 +76,8 @@ type SubPolicyCfg struct {
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for trace_state policy evaluator.
 	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for tql_condition policy evaluator.
+	TQLConditionCfg TQLConditionCfg `mapstructure:"tql_condition"`
 }
 
 type AndSubPolicyCfg struct {
@@ -97,6 +101,8 @@ type AndSubPolicyCfg struct {
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for trace_state filter sampling policy evaluator
 	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for tql_condition filter sampling policy evaluator
+	TQLConditionCfg TQLConditionCfg `mapstructure:"tql_condition"`
 }
 
 type TraceStateCfg struct {
@@ -106,6 +112,16 @@ type TraceStateCfg struct {
 	Values []string `mapstructure:"values"`
 }
 
+// TQLConditionCfg holds the configurable settings to create a TQL condition filter
+// sampling policy evaluator.
+type TQLConditionCfg struct {
+	// SpanConditions is a list of TQL conditions for a span, a trace is sampled if any span matches any condition.
+	SpanConditions []string `mapstructure:"span"`
+	// SpanEventConditions is a list of TQL conditions for a span event, a trace is sampled if any span event
+	// matches any condition.
+	SpanEventConditions []string `mapstructure:"spanevent"`
+}
+
 type AndCfg struct {
 	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"and_sub_policy"`
 }
@@ -151,6 +167,8 @@ type PolicyCfg struct {
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for defining trace_state policy
 	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for defining tql_condition policy
+	TQLConditionCfg TQLConditionCfg `mapstructure:"tql_condition"`
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
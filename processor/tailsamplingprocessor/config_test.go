@@ -88,6 +88,17 @@ func TestLoadConfig(t *testing.T) {
 					Type:          TraceState,
 					TraceStateCfg: TraceStateCfg{Key: "key3", Values: []string{"value1", "value2"}},
 				},
+				{
+					Name: "test-policy-10",
+					Type: TQLCondition,
+					TQLConditionCfg: TQLConditionCfg{
+						SpanConditions: []string{
+							`attributes["test_attr_key_1"] == "test_attr_val_1"`,
+							`kind == 2 and end_time_unix_nano - start_time_unix_nano > 5000000000`,
+						},
+						SpanEventConditions: []string{`name == "exception"`},
+					},
+				},
 				{
 					Name: "and-policy-1",
 					Type: And,
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlfunctions"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// conditionFunctions are the functions available to TQL conditions.
+var conditionFunctions = tqlfunctions.Converters()
+
+type tqlConditionFilter struct {
+	spanConditions      []tql.CondFunc
+	spanEventConditions []tql.CondFunc
+	logger              *zap.Logger
+}
+
+var _ PolicyEvaluator = (*tqlConditionFilter)(nil)
+
+// NewTQLConditionFilter creates a policy evaluator that samples all traces with
+// a span or span event matching any of the given TQL conditions.
+func NewTQLConditionFilter(logger *zap.Logger, spanConditions, spanEventConditions []string) (PolicyEvaluator, error) {
+	if len(spanConditions) == 0 && len(spanEventConditions) == 0 {
+		return nil, errors.New("expected at least one span or span event condition, none was given")
+	}
+
+	spanCondFuncs, err := tql.ParseConditions(spanConditions, conditionFunctions, tqltraces.ParsePath)
+	if err != nil {
+		return nil, err
+	}
+	spanEventCondFuncs, err := tql.ParseConditions(spanEventConditions, conditionFunctions, tqltraces.ParseSpanEventPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tqlConditionFilter{
+		spanConditions:      spanCondFuncs,
+		spanEventConditions: spanEventCondFuncs,
+		logger:              logger,
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (tcf *tqlConditionFilter) Evaluate(_ pcommon.TraceID, trace *TraceData) (Decision, error) {
+	trace.Lock()
+	batches := trace.ReceivedBatches
+	trace.Unlock()
+
+	for _, batch := range batches {
+		rspans := batch.ResourceSpans()
+
+		for i := 0; i < rspans.Len(); i++ {
+			rs := rspans.At(i)
+			ilss := rs.ScopeSpans()
+
+			for j := 0; j < ilss.Len(); j++ {
+				ils := ilss.At(j)
+
+				for k := 0; k < ils.Spans().Len(); k++ {
+					span := ils.Spans().At(k)
+
+					if tql.MatchesAnyCondition(tcf.spanConditions, tqltraces.NewTransformContext(span, ils.Scope(), rs.Resource())) {
+						return Sampled, nil
+					}
+
+					for l := 0; l < span.Events().Len(); l++ {
+						ctx := tqltraces.NewSpanEventTransformContext(span.Events().At(l), span, ils.Scope(), rs.Resource())
+						if tql.MatchesAnyCondition(tcf.spanEventConditions, ctx) {
+							return Sampled, nil
+						}
+					}
+				}
+			}
+		}
+	}
+	return NotSampled, nil
+}
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestTQLConditionFilter(t *testing.T) {
+	cases := []struct {
+		Desc                string
+		SpanConditions      []string
+		SpanEventConditions []string
+		Decision            Decision
+	}{
+		{
+			Desc:           "matching span attribute",
+			SpanConditions: []string{`attributes["http.status_code"] == 500`},
+			Decision:       Sampled,
+		},
+		{
+			Desc:           "nonmatching span attribute",
+			SpanConditions: []string{`attributes["http.status_code"] == 200`},
+			Decision:       NotSampled,
+		},
+		{
+			Desc:           "matching span kind and resource attribute",
+			SpanConditions: []string{`kind == 2 and resource.attributes["service.name"] == "checkout"`},
+			Decision:       Sampled,
+		},
+		{
+			Desc:           "matching span duration",
+			SpanConditions: []string{`end_time_unix_nano - start_time_unix_nano > 5000000000`},
+			Decision:       Sampled,
+		},
+		{
+			Desc:           "nonmatching span duration",
+			SpanConditions: []string{`end_time_unix_nano - start_time_unix_nano > 10000000000`},
+			Decision:       NotSampled,
+		},
+		{
+			Desc:           "matching any of the span conditions",
+			SpanConditions: []string{`name == "non_matching"`, `IsMatch(name, "^GET /.*") == true`},
+			Decision:       Sampled,
+		},
+		{
+			Desc:                "matching span event name",
+			SpanEventConditions: []string{`name == "exception"`},
+			Decision:            Sampled,
+		},
+		{
+			Desc:                "matching span event attribute",
+			SpanEventConditions: []string{`attributes["exception.type"] == "java.lang.NullPointerException"`},
+			Decision:            Sampled,
+		},
+		{
+			Desc:                "nonmatching span event",
+			SpanEventConditions: []string{`name == "non_matching"`},
+			Decision:            NotSampled,
+		},
+		{
+			Desc:                "matching span event when span conditions do not match",
+			SpanConditions:      []string{`name == "non_matching"`},
+			SpanEventConditions: []string{`name == "exception"`},
+			Decision:            Sampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			filter, err := NewTQLConditionFilter(zap.NewNop(), c.SpanConditions, c.SpanEventConditions)
+			require.NoError(t, err)
+			decision, err := filter.Evaluate(pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}), newTraceWithEvent())
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func TestTQLConditionFilterInvalidConditions(t *testing.T) {
+	_, err := NewTQLConditionFilter(zap.NewNop(), nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewTQLConditionFilter(zap.NewNop(), []string{`attributes["key"] ==`}, nil)
+	assert.Error(t, err)
+
+	_, err = NewTQLConditionFilter(zap.NewNop(), nil, []string{`unknown == "value"`})
+	assert.Error(t, err)
+}
+
+func newTraceWithEvent() *TraceData {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("service.name", "checkout")
+	ils := rs.ScopeSpans().AppendEmpty()
+	span := ils.Spans().AppendEmpty()
+	span.SetTraceID(pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pcommon.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(1, 0)))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Unix(7, 0)))
+	span.Attributes().InsertInt("http.status_code", 500)
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.Attributes().InsertString("exception.type", "java.lang.NullPointerException")
+	return &TraceData{
+		ReceivedBatches: []ptrace.Traces{traces},
+	}
+}
@@ -15,6 +15,7 @@
 package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
 
 import (
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.uber.org/multierr"
 
@@ -25,9 +26,16 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/traces"
 )
 
+// ContextQueries are queries executed in a context other than the default one of the signal.
+type ContextQueries = common.ContextQueries
+
 type SignalConfig struct {
+	// Queries are executed in the default context of the signal.
 	Queries []string `mapstructure:"queries"`
 
+	// Contexts are executed in order, after Queries.
+	Contexts []ContextQueries `mapstructure:"contexts"`
+
 	// The functions that have been registered in the extension for processing.
 	functions map[string]interface{} `mapstructure:"-"`
 }
@@ -44,15 +52,15 @@ var _ config.Processor = (*Config)(nil)
 
 func (c *Config) Validate() error {
 	var errors error
-	_, err := common.ParseQueries(c.Traces.Queries, c.Traces.functions, traces.ParsePath)
+	_, err := traces.NewProcessor(c.Traces.Queries, c.Traces.Contexts, c.Traces.functions, component.ProcessorCreateSettings{})
 	if err != nil {
 		errors = multierr.Append(errors, err)
 	}
-	_, err = common.ParseQueries(c.Metrics.Queries, c.Metrics.functions, metrics.ParsePath)
+	_, err = metrics.NewProcessor(c.Metrics.Queries, c.Metrics.Contexts, c.Metrics.functions, component.ProcessorCreateSettings{})
 	if err != nil {
 		errors = multierr.Append(errors, err)
 	}
-	_, err = common.ParseQueries(c.Logs.Queries, c.Logs.functions, logs.ParsePath)
+	_, err = logs.NewProcessor(c.Logs.Queries, c.Logs.Contexts, c.Logs.functions, component.ProcessorCreateSettings{})
 	if err != nil {
 		errors = multierr.Append(errors, err)
 	}
@@ -48,6 +48,16 @@ func TestLoadingConfig(t *testing.T) {
 				`set(name, "bear") where attributes["http.path"] == "/animal"`,
 				`keep_keys(attributes, "http.method", "http.path")`,
 			},
+			Contexts: []ContextQueries{
+				{
+					Context: "span_event",
+					Queries: []string{`set(attributes["exception.stacktrace"], "redacted") where name == "exception"`},
+				},
+				{
+					Context: "span_link",
+					Queries: []string{`drop() where span.name == "healthcheck"`},
+				},
+			},
 
 			functions: traces.DefaultFunctions(),
 		},
@@ -56,6 +66,12 @@ func TestLoadingConfig(t *testing.T) {
 				`set(metric.name, "bear") where attributes["http.path"] == "/animal"`,
 				`keep_keys(attributes, "http.method", "http.path")`,
 			},
+			Contexts: []ContextQueries{
+				{
+					Context: "resource",
+					Queries: []string{`set(attributes["env"], "prod")`},
+				},
+			},
 
 			functions: metrics.DefaultFunctions(),
 		},
@@ -64,6 +80,12 @@ func TestLoadingConfig(t *testing.T) {
 				`set(body, "bear") where attributes["http.path"] == "/animal"`,
 				`keep_keys(attributes, "http.method", "http.path")`,
 			},
+			Contexts: []ContextQueries{
+				{
+					Context: "scope",
+					Queries: []string{`set(version, "1.0") where name == "bear"`},
+				},
+			},
 
 			functions: logs.DefaultFunctions(),
 		},
@@ -85,6 +107,18 @@ func TestLoadInvalidConfig(t *testing.T) {
 	assert.Error(t, err)
 	assert.NotNil(t, cfg)
 
+	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_unknown_context_trace.yaml"), factories)
+	assert.Error(t, err)
+	assert.NotNil(t, cfg)
+
+	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_drop_resource_trace.yaml"), factories)
+	assert.Error(t, err)
+	assert.NotNil(t, cfg)
+
+	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_drop_scope_trace.yaml"), factories)
+	assert.Error(t, err)
+	assert.NotNil(t, cfg)
+
 	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_bad_syntax_metric.yaml"), factories)
 	assert.Error(t, err)
 	assert.NotNil(t, cfg)
@@ -93,6 +127,10 @@ func TestLoadInvalidConfig(t *testing.T) {
 	assert.Error(t, err)
 	assert.NotNil(t, cfg)
 
+	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_unknown_context_metric.yaml"), factories)
+	assert.Error(t, err)
+	assert.NotNil(t, cfg)
+
 	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_bad_syntax_log.yaml"), factories)
 	assert.Error(t, err)
 	assert.NotNil(t, cfg)
@@ -100,4 +138,8 @@ func TestLoadInvalidConfig(t *testing.T) {
 	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_unknown_function_log.yaml"), factories)
 	assert.Error(t, err)
 	assert.NotNil(t, cfg)
+
+	cfg, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid_config_bad_path_resource_log.yaml"), factories)
+	assert.Error(t, err)
+	assert.NotNil(t, cfg)
 }
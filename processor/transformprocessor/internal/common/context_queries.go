@@ -0,0 +1,41 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+// The contexts common to all signals.
+const (
+	ResourceContext = "resource"
+	ScopeContext    = "scope"
+)
+
+// ContextQueries are queries executed in a context. The context selects the telemetry items the queries are
+// executed on, such as spans or span events, and the paths that can be used in the queries.
+type ContextQueries struct {
+	Context string   `mapstructure:"context"`
+	Queries []string `mapstructure:"queries"`
+}
+
+// ExecuteQueries executes the queries whose condition is met on the context, in order.
+func ExecuteQueries(ctx tql.TransformContext, queries []tql.Query) {
+	for _, query := range queries {
+		if query.Condition(ctx) {
+			query.Function(ctx)
+		}
+	}
+}
@@ -16,50 +16,107 @@ package logs // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqllogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
+const LogContext = "log"
+
+// contexts maps the name of each context to the parser of its paths and to the function executing its queries.
+var contexts = map[string]struct {
+	parsePath tql.PathExpressionParser
+	process   func(ld plog.Logs, queries []tql.Query)
+}{
+	common.ResourceContext: {parsePath: tqlresource.ParsePath, process: processResources},
+	common.ScopeContext:    {parsePath: tqlscope.ParsePath, process: processScopes},
+	LogContext:             {parsePath: tqllogs.ParsePath, process: processLogs},
+}
+
+func contextNames() []string {
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type contextQueries struct {
+	process func(ld plog.Logs, queries []tql.Query)
+	queries []tql.Query
+}
+
 type Processor struct {
-	queries []common.Query
-	logger  *zap.Logger
+	contexts []contextQueries
+	logger   *zap.Logger
 }
 
-func NewProcessor(statements []string, functions map[string]interface{}, settings component.ProcessorCreateSettings) (*Processor, error) {
-	queries, err := common.ParseQueries(statements, functions, ParsePath)
-	if err != nil {
-		return nil, err
+// NewProcessor creates a Processor executing the statements in the log context, followed by the statements of
+// each of the given contexts in order.
+func NewProcessor(statements []string, contextStatements []common.ContextQueries, functions map[string]interface{}, settings component.ProcessorCreateSettings) (*Processor, error) {
+	all := append([]common.ContextQueries{{Context: LogContext, Queries: statements}}, contextStatements...)
+	parsed := make([]contextQueries, 0, len(all))
+	for _, cq := range all {
+		c, ok := contexts[cq.Context]
+		if !ok {
+			return nil, fmt.Errorf("unknown context %q, must be one of %q", cq.Context, contextNames())
+		}
+		queries, err := tql.ParseQueries(cq.Queries, functions, c.parsePath)
+		if err != nil {
+			return nil, err
+		}
+		if len(queries) == 0 {
+			continue
+		}
+		parsed = append(parsed, contextQueries{process: c.process, queries: queries})
 	}
 	return &Processor{
-		queries: queries,
-		logger:  settings.Logger,
+		contexts: parsed,
+		logger:   settings.Logger,
 	}, nil
 }
 
 func (p *Processor) ProcessLogs(_ context.Context, td plog.Logs) (plog.Logs, error) {
-	ctx := logTransformContext{}
-	for i := 0; i < td.ResourceLogs().Len(); i++ {
-		rlogs := td.ResourceLogs().At(i)
-		ctx.resource = rlogs.Resource()
+	for _, c := range p.contexts {
+		c.process(td, c.queries)
+	}
+	return td, nil
+}
+
+func processResources(ld plog.Logs, queries []tql.Query) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		common.ExecuteQueries(tqlresource.NewTransformContext(ld.ResourceLogs().At(i).Resource()), queries)
+	}
+}
+
+func processScopes(ld plog.Logs, queries []tql.Query) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rlogs := ld.ResourceLogs().At(i)
+		for j := 0; j < rlogs.ScopeLogs().Len(); j++ {
+			common.ExecuteQueries(tqlscope.NewTransformContext(rlogs.ScopeLogs().At(j).Scope(), rlogs.Resource()), queries)
+		}
+	}
+}
+
+func processLogs(ld plog.Logs, queries []tql.Query) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rlogs := ld.ResourceLogs().At(i)
 		for j := 0; j < rlogs.ScopeLogs().Len(); j++ {
 			slogs := rlogs.ScopeLogs().At(j)
-			ctx.il = slogs.Scope()
 			logs := slogs.LogRecords()
 			for k := 0; k < logs.Len(); k++ {
-				log := logs.At(k)
-				ctx.log = log
-
-				for _, statement := range p.queries {
-					if statement.Condition(ctx) {
-						statement.Function(ctx)
-					}
-				}
+				common.ExecuteQueries(tqllogs.NewTransformContext(logs.At(k), slogs.Scope(), rlogs.Resource()), queries)
 			}
 		}
 	}
-	return td, nil
 }
@@ -23,9 +23,14 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 var (
+	traceID = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	spanID  = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+
 	TestLogTime      = time.Date(2020, 2, 11, 20, 26, 12, 321, time.UTC)
 	TestLogTimestamp = pcommon.NewTimestampFromTime(TestLogTime)
 
@@ -109,6 +114,47 @@ func TestProcess(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertString("test", "pass")
 			},
 		},
+		{
+			query: `set(attributes["test"], Concat(": ", attributes["http.method"], body))`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertString("test", "get: operationA")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().InsertString("test", "get: operationB")
+			},
+		},
+		{
+			query: `set(attributes["test"], ConvertCase(body, "snake")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertString("test", "operation_a")
+			},
+		},
+		{
+			query: `set(attributes["test"], Split(attributes["http.path"], "/")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				arr := pcommon.NewValueSlice()
+				arr.SliceVal().AppendEmpty().SetStringVal("")
+				arr.SliceVal().AppendEmpty().SetStringVal("health")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Insert("test", arr)
+			},
+		},
+		{
+			query: `set(attributes["test"], Int(Concat("", dropped_attributes_count, "0"))) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertInt("test", 10)
+			},
+		},
+		{
+			query: `set(attributes["test"], Double(String(flags))) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertDouble("test", 1)
+			},
+		},
+		{
+			query: `merge_maps(attributes, ExtractPatterns(attributes["http.url"], "^(?P<scheme>[a-z]+)://(?P<host>[^/]+)"), "upsert") where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertString("scheme", "http")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertString("host", "localhost")
+			},
+		},
 		{
 			query: `delete_key(attributes, "http.url") where body == "operationA"`,
 			want: func(td plog.Logs) {
@@ -129,13 +175,87 @@ func TestProcess(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.query, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]string{tt.query}, DefaultFunctions(), component.ProcessorCreateSettings{})
+			processor, err := NewProcessor([]string{tt.query}, nil, DefaultFunctions(), component.ProcessorCreateSettings{})
+			assert.NoError(t, err)
+
+			_, err = processor.ProcessLogs(context.Background(), td)
+			assert.NoError(t, err)
+
+			exTd := constructLogs()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
+func TestProcess_Contexts(t *testing.T) {
+	tests := []struct {
+		name     string
+		queries  []string
+		contexts []common.ContextQueries
+		want     func(td plog.Logs)
+	}{
+		{
+			name: "set resource attribute",
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ResourceContext,
+					Queries: []string{`set(attributes["env"], "prod")`},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).Resource().Attributes().InsertString("env", "prod")
+				td.ResourceLogs().At(1).Resource().Attributes().InsertString("env", "prod")
+			},
+		},
+		{
+			name: "set scope name",
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ScopeContext,
+					Queries: []string{`set(name, "scope") where resource.attributes["host.name"] == "localhost"`},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).Scope().SetName("scope")
+			},
+		},
+		{
+			name:    "contexts run after queries",
+			queries: []string{`set(attributes["test"], "pass") where body == "operationA"`},
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ResourceContext,
+					Queries: []string{`set(attributes["test"], "pass")`},
+				},
+				{
+					Context: LogContext,
+					Queries: []string{`set(attributes["test"], resource.attributes["test"]) where body == "operationB"`},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).Resource().Attributes().InsertString("test", "pass")
+				td.ResourceLogs().At(1).Resource().Attributes().InsertString("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().InsertString("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().InsertString("test", "pass")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := constructLogs()
+			// A resource without any log records.
+			td.ResourceLogs().AppendEmpty().Resource().Attributes().InsertString("host.name", "remotehost")
+			processor, err := NewProcessor(tt.queries, tt.contexts, DefaultFunctions(), component.ProcessorCreateSettings{})
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
 			assert.NoError(t, err)
 
 			exTd := constructLogs()
+			exTd.ResourceLogs().AppendEmpty().Resource().Attributes().InsertString("host.name", "remotehost")
 			tt.want(exTd)
 
 			assert.Equal(t, exTd, td)
@@ -143,6 +263,11 @@ func TestProcess(t *testing.T) {
 	}
 }
 
+func TestNewProcessor_UnknownContext(t *testing.T) {
+	_, err := NewProcessor(nil, []common.ContextQueries{{Context: "span", Queries: []string{`set(name, "bear")`}}}, DefaultFunctions(), component.ProcessorCreateSettings{})
+	assert.Error(t, err)
+}
+
 func constructLogs() plog.Logs {
 	td := plog.NewLogs()
 	rs0 := td.ResourceLogs().AppendEmpty()
@@ -19,10 +19,11 @@ import (
 
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlmetrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 )
 
-func convertGaugeToSum(stringAggTemp string, monotonic bool) (common.ExprFunc, error) {
+func convertGaugeToSum(stringAggTemp string, monotonic bool) (tql.ExprFunc, error) {
 	var aggTemp pmetric.MetricAggregationTemporality
 	switch stringAggTemp {
 	case "delta":
@@ -33,8 +34,8 @@ func convertGaugeToSum(stringAggTemp string, monotonic bool) (common.ExprFunc, e
 		return nil, fmt.Errorf("unknown aggregation temporality: %s", stringAggTemp)
 	}
 
-	return func(ctx common.TransformContext) interface{} {
-		mtc, ok := ctx.(metricTransformContext)
+	return func(ctx tql.TransformContext) interface{} {
+		mtc, ok := ctx.(tqlmetrics.TransformContext)
 		if !ok {
 			return nil
 		}
@@ -16,92 +16,146 @@ package metrics // import "github.com/open-telemetry/opentelemetry-collector-con
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlmetrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
+const DataPointContext = "datapoint"
+
+// contexts maps the name of each context to the parser of its paths and to the function executing its queries.
+var contexts = map[string]struct {
+	parsePath tql.PathExpressionParser
+	process   func(md pmetric.Metrics, queries []tql.Query)
+}{
+	common.ResourceContext: {parsePath: tqlresource.ParsePath, process: processResources},
+	common.ScopeContext:    {parsePath: tqlscope.ParsePath, process: processScopes},
+	DataPointContext:       {parsePath: tqlmetrics.ParsePath, process: processDataPoints},
+}
+
+func contextNames() []string {
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type contextQueries struct {
+	process func(md pmetric.Metrics, queries []tql.Query)
+	queries []tql.Query
+}
+
 type Processor struct {
-	queries []common.Query
-	logger  *zap.Logger
+	contexts []contextQueries
+	logger   *zap.Logger
 }
 
-func NewProcessor(statements []string, functions map[string]interface{}, settings component.ProcessorCreateSettings) (*Processor, error) {
-	queries, err := common.ParseQueries(statements, functions, ParsePath)
-	if err != nil {
-		return nil, err
+// NewProcessor creates a Processor executing the statements in the datapoint context, followed by the statements of
+// each of the given contexts in order.
+func NewProcessor(statements []string, contextStatements []common.ContextQueries, functions map[string]interface{}, settings component.ProcessorCreateSettings) (*Processor, error) {
+	all := append([]common.ContextQueries{{Context: DataPointContext, Queries: statements}}, contextStatements...)
+	parsed := make([]contextQueries, 0, len(all))
+	for _, cq := range all {
+		c, ok := contexts[cq.Context]
+		if !ok {
+			return nil, fmt.Errorf("unknown context %q, must be one of %q", cq.Context, contextNames())
+		}
+		queries, err := tql.ParseQueries(cq.Queries, functions, c.parsePath)
+		if err != nil {
+			return nil, err
+		}
+		if len(queries) == 0 {
+			continue
+		}
+		parsed = append(parsed, contextQueries{process: c.process, queries: queries})
 	}
 	return &Processor{
-		queries: queries,
-		logger:  settings.Logger,
+		contexts: parsed,
+		logger:   settings.Logger,
 	}, nil
 }
 
 func (p *Processor) ProcessMetrics(_ context.Context, td pmetric.Metrics) (pmetric.Metrics, error) {
-	ctx := metricTransformContext{}
-	for i := 0; i < td.ResourceMetrics().Len(); i++ {
-		rmetrics := td.ResourceMetrics().At(i)
-		ctx.resource = rmetrics.Resource()
+	for _, c := range p.contexts {
+		c.process(td, c.queries)
+	}
+	return td, nil
+}
+
+func processResources(md pmetric.Metrics, queries []tql.Query) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		common.ExecuteQueries(tqlresource.NewTransformContext(md.ResourceMetrics().At(i).Resource()), queries)
+	}
+}
+
+func processScopes(md pmetric.Metrics, queries []tql.Query) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rmetrics := md.ResourceMetrics().At(i)
+		for j := 0; j < rmetrics.ScopeMetrics().Len(); j++ {
+			common.ExecuteQueries(tqlscope.NewTransformContext(rmetrics.ScopeMetrics().At(j).Scope(), rmetrics.Resource()), queries)
+		}
+	}
+}
+
+func processDataPoints(md pmetric.Metrics, queries []tql.Query) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rmetrics := md.ResourceMetrics().At(i)
 		for j := 0; j < rmetrics.ScopeMetrics().Len(); j++ {
 			smetrics := rmetrics.ScopeMetrics().At(j)
-			ctx.il = smetrics.Scope()
 			metrics := smetrics.Metrics()
-			ctx.metrics = metrics
 			for k := 0; k < metrics.Len(); k++ {
-				ctx.metric = metrics.At(k)
-				switch ctx.metric.DataType() {
+				metric := metrics.At(k)
+				newContext := func(dataPoint interface{}) tql.TransformContext {
+					return tqlmetrics.NewTransformContext(dataPoint, metric, metrics, smetrics.Scope(), rmetrics.Resource())
+				}
+				switch metric.DataType() {
 				case pmetric.MetricDataTypeSum:
-					p.handleNumberDataPoints(ctx, ctx.metric.Sum().DataPoints())
+					handleNumberDataPoints(newContext, metric.Sum().DataPoints(), queries)
 				case pmetric.MetricDataTypeGauge:
-					p.handleNumberDataPoints(ctx, ctx.metric.Gauge().DataPoints())
+					handleNumberDataPoints(newContext, metric.Gauge().DataPoints(), queries)
 				case pmetric.MetricDataTypeHistogram:
-					p.handleHistogramDataPoints(ctx, ctx.metric.Histogram().DataPoints())
+					handleHistogramDataPoints(newContext, metric.Histogram().DataPoints(), queries)
 				case pmetric.MetricDataTypeExponentialHistogram:
-					p.handleExponetialHistogramDataPoints(ctx, ctx.metric.ExponentialHistogram().DataPoints())
+					handleExponetialHistogramDataPoints(newContext, metric.ExponentialHistogram().DataPoints(), queries)
 				case pmetric.MetricDataTypeSummary:
-					p.handleSummaryDataPoints(ctx, ctx.metric.Summary().DataPoints())
+					handleSummaryDataPoints(newContext, metric.Summary().DataPoints(), queries)
 				}
 			}
 		}
 	}
-	return td, nil
 }
 
-func (p *Processor) handleNumberDataPoints(ctx metricTransformContext, dps pmetric.NumberDataPointSlice) {
+func handleNumberDataPoints(newContext func(interface{}) tql.TransformContext, dps pmetric.NumberDataPointSlice, queries []tql.Query) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx.dataPoint = dps.At(i)
-		p.callFunctions(ctx)
+		common.ExecuteQueries(newContext(dps.At(i)), queries)
 	}
 }
 
-func (p *Processor) handleHistogramDataPoints(ctx metricTransformContext, dps pmetric.HistogramDataPointSlice) {
+func handleHistogramDataPoints(newContext func(interface{}) tql.TransformContext, dps pmetric.HistogramDataPointSlice, queries []tql.Query) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx.dataPoint = dps.At(i)
-		p.callFunctions(ctx)
+		common.ExecuteQueries(newContext(dps.At(i)), queries)
 	}
 }
 
-func (p *Processor) handleExponetialHistogramDataPoints(ctx metricTransformContext, dps pmetric.ExponentialHistogramDataPointSlice) {
+func handleExponetialHistogramDataPoints(newContext func(interface{}) tql.TransformContext, dps pmetric.ExponentialHistogramDataPointSlice, queries []tql.Query) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx.dataPoint = dps.At(i)
-		p.callFunctions(ctx)
+		common.ExecuteQueries(newContext(dps.At(i)), queries)
 	}
 }
 
-func (p *Processor) handleSummaryDataPoints(ctx metricTransformContext, dps pmetric.SummaryDataPointSlice) {
+func handleSummaryDataPoints(newContext func(interface{}) tql.TransformContext, dps pmetric.SummaryDataPointSlice, queries []tql.Query) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx.dataPoint = dps.At(i)
-		p.callFunctions(ctx)
-	}
-}
-
-func (p *Processor) callFunctions(ctx metricTransformContext) {
-	for _, statement := range p.queries {
-		if statement.Condition(ctx) {
-			statement.Function(ctx)
-		}
+		common.ExecuteQueries(newContext(dps.At(i)), queries)
 	}
 }
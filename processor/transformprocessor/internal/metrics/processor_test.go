@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 var (
@@ -271,7 +273,7 @@ func TestProcess(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.query[0], func(t *testing.T) {
 			td := constructMetrics()
-			processor, err := NewProcessor(tt.query, DefaultFunctions(), component.ProcessorCreateSettings{})
+			processor, err := NewProcessor(tt.query, nil, DefaultFunctions(), component.ProcessorCreateSettings{})
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessMetrics(context.Background(), td)
@@ -285,6 +287,77 @@ func TestProcess(t *testing.T) {
 	}
 }
 
+func TestProcess_Contexts(t *testing.T) {
+	tests := []struct {
+		name     string
+		queries  []string
+		contexts []common.ContextQueries
+		want     func(td pmetric.Metrics)
+	}{
+		{
+			name: "set resource attribute",
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ResourceContext,
+					Queries: []string{`set(attributes["env"], "prod")`},
+				},
+			},
+			want: func(td pmetric.Metrics) {
+				td.ResourceMetrics().At(0).Resource().Attributes().InsertString("env", "prod")
+				td.ResourceMetrics().At(1).Resource().Attributes().InsertString("env", "prod")
+			},
+		},
+		{
+			name: "delete resource attribute",
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ResourceContext,
+					Queries: []string{`delete_key(attributes, "host.name") where attributes["host.name"] == "remotehost"`},
+				},
+			},
+			want: func(td pmetric.Metrics) {
+				td.ResourceMetrics().At(1).Resource().Attributes().Remove("host.name")
+			},
+		},
+		{
+			name: "set scope version",
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ScopeContext,
+					Queries: []string{`set(version, "1.0") where resource.attributes["host.name"] == "myhost"`},
+				},
+			},
+			want: func(td pmetric.Metrics) {
+				td.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().SetVersion("1.0")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := constructMetrics()
+			// A resource without any metrics.
+			td.ResourceMetrics().AppendEmpty().Resource().Attributes().InsertString("host.name", "remotehost")
+			processor, err := NewProcessor(tt.queries, tt.contexts, DefaultFunctions(), component.ProcessorCreateSettings{})
+			assert.NoError(t, err)
+
+			_, err = processor.ProcessMetrics(context.Background(), td)
+			assert.NoError(t, err)
+
+			exTd := constructMetrics()
+			exTd.ResourceMetrics().AppendEmpty().Resource().Attributes().InsertString("host.name", "remotehost")
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
+func TestNewProcessor_UnknownContext(t *testing.T) {
+	_, err := NewProcessor(nil, []common.ContextQueries{{Context: "log", Queries: []string{`set(attributes["test"], "pass")`}}}, DefaultFunctions(), component.ProcessorCreateSettings{})
+	assert.Error(t, err)
+}
+
 func constructMetrics() pmetric.Metrics {
 	td := pmetric.NewMetrics()
 	rm0 := td.ResourceMetrics().AppendEmpty()
@@ -18,7 +18,29 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
+// registry is a map of names to functions for traces pipelines
+var registry = map[string]interface{}{
+	"drop": dropItem,
+}
+
+func init() {
+	// Init traces registry with default functions common to all signals
+	for k, v := range common.DefaultFunctions() {
+		registry[k] = v
+	}
+}
+
 func DefaultFunctions() map[string]interface{} {
-	// No trace-only functions yet.
-	return common.DefaultFunctions()
+	return registry
+}
+
+// withoutDrop returns the functions but drop, for the contexts whose items can't be removed
+func withoutDrop(functions map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(functions))
+	for name, f := range functions {
+		if name != "drop" {
+			result[name] = f
+		}
+	}
+	return result
 }
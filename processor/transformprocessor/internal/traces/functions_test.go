@@ -23,5 +23,17 @@ import (
 )
 
 func Test_DefaultFunctions(t *testing.T) {
-	assert.Equal(t, common.DefaultFunctions(), DefaultFunctions())
+	expectedFunctions := common.DefaultFunctions()
+	expectedFunctions["drop"] = dropItem
+
+	actual := DefaultFunctions()
+
+	assert.NotNil(t, actual)
+	assert.Equal(t, len(expectedFunctions), len(actual))
+
+	for k := range actual {
+		if _, ok := expectedFunctions[k]; !ok {
+			assert.FailNowf(t, "%v is not an expected function", k)
+		}
+	}
 }
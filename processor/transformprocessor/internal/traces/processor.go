@@ -16,49 +16,163 @@ package traces // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqlscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
+const (
+	SpanContext      = "span"
+	SpanEventContext = "span_event"
+	SpanLinkContext  = "span_link"
+)
+
+// contexts maps the name of each context to the parser of its paths, to the function executing its queries, and
+// to whether its items can be removed with drop.
+var contexts = map[string]struct {
+	parsePath tql.PathExpressionParser
+	process   func(td ptrace.Traces, queries []tql.Query)
+	canDrop   bool
+}{
+	common.ResourceContext: {parsePath: tqlresource.ParsePath, process: processResources},
+	common.ScopeContext:    {parsePath: tqlscope.ParsePath, process: processScopes},
+	SpanContext:            {parsePath: tqltraces.ParsePath, process: processSpans, canDrop: true},
+	SpanEventContext:       {parsePath: tqltraces.ParseSpanEventPath, process: processSpanEvents, canDrop: true},
+	SpanLinkContext:        {parsePath: tqltraces.ParseSpanLinkPath, process: processSpanLinks, canDrop: true},
+}
+
+func contextNames() []string {
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type contextQueries struct {
+	process func(td ptrace.Traces, queries []tql.Query)
+	queries []tql.Query
+}
+
 type Processor struct {
-	queries []common.Query
-	logger  *zap.Logger
+	contexts []contextQueries
+	logger   *zap.Logger
 }
 
-func NewProcessor(statements []string, functions map[string]interface{}, settings component.ProcessorCreateSettings) (*Processor, error) {
-	queries, err := common.ParseQueries(statements, functions, ParsePath)
-	if err != nil {
-		return nil, err
+// NewProcessor creates a Processor executing the statements in the span context, followed by the statements of
+// each of the given contexts in order.
+func NewProcessor(statements []string, contextStatements []common.ContextQueries, functions map[string]interface{}, settings component.ProcessorCreateSettings) (*Processor, error) {
+	all := append([]common.ContextQueries{{Context: SpanContext, Queries: statements}}, contextStatements...)
+	parsed := make([]contextQueries, 0, len(all))
+	for _, cq := range all {
+		c, ok := contexts[cq.Context]
+		if !ok {
+			return nil, fmt.Errorf("unknown context %q, must be one of %q", cq.Context, contextNames())
+		}
+		contextFunctions := functions
+		if !c.canDrop {
+			contextFunctions = withoutDrop(functions)
+		}
+		queries, err := tql.ParseQueries(cq.Queries, contextFunctions, c.parsePath)
+		if err != nil {
+			return nil, err
+		}
+		if len(queries) == 0 {
+			continue
+		}
+		parsed = append(parsed, contextQueries{process: c.process, queries: queries})
 	}
 	return &Processor{
-		queries: queries,
-		logger:  settings.Logger,
+		contexts: parsed,
+		logger:   settings.Logger,
 	}, nil
 }
 
 func (p *Processor) ProcessTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
-	ctx := spanTransformContext{}
+	for _, c := range p.contexts {
+		c.process(td, c.queries)
+	}
+	return td, nil
+}
+
+func processResources(td ptrace.Traces, queries []tql.Query) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		common.ExecuteQueries(tqlresource.NewTransformContext(td.ResourceSpans().At(i).Resource()), queries)
+	}
+}
+
+func processScopes(td ptrace.Traces, queries []tql.Query) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rspans := td.ResourceSpans().At(i)
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			common.ExecuteQueries(tqlscope.NewTransformContext(rspans.ScopeSpans().At(j).Scope(), rspans.Resource()), queries)
+		}
+	}
+}
+
+func processSpans(td ptrace.Traces, queries []tql.Query) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rspans := td.ResourceSpans().At(i)
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			sspan := rspans.ScopeSpans().At(j)
+			sspan.Spans().RemoveIf(func(span ptrace.Span) bool {
+				return executeQueries(tqltraces.NewTransformContext(span, sspan.Scope(), rspans.Resource()), queries)
+			})
+		}
+	}
+}
+
+func processSpanEvents(td ptrace.Traces, queries []tql.Query) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rspans := td.ResourceSpans().At(i)
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			sspan := rspans.ScopeSpans().At(j)
+			spans := sspan.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				span.Events().RemoveIf(func(event ptrace.SpanEvent) bool {
+					return executeQueries(tqltraces.NewSpanEventTransformContext(event, span, sspan.Scope(), rspans.Resource()), queries)
+				})
+			}
+		}
+	}
+}
+
+func processSpanLinks(td ptrace.Traces, queries []tql.Query) {
 	for i := 0; i < td.ResourceSpans().Len(); i++ {
 		rspans := td.ResourceSpans().At(i)
-		ctx.resource = rspans.Resource()
 		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
 			sspan := rspans.ScopeSpans().At(j)
-			ctx.il = sspan.Scope()
 			spans := sspan.Spans()
 			for k := 0; k < spans.Len(); k++ {
-				ctx.span = spans.At(k)
+				span := spans.At(k)
+				span.Links().RemoveIf(func(link ptrace.SpanLink) bool {
+					return executeQueries(tqltraces.NewSpanLinkTransformContext(link, span, sspan.Scope(), rspans.Resource()), queries)
+				})
+			}
+		}
+	}
+}
 
-				for _, statement := range p.queries {
-					if statement.Condition(ctx) {
-						statement.Function(ctx)
-					}
-				}
+// executeQueries executes the queries on the context, and reports whether its item has been dropped. No queries are
+// executed once the item is dropped.
+func executeQueries(ctx tql.TransformContext, queries []tql.Query) bool {
+	for _, query := range queries {
+		if query.Condition(ctx) {
+			if _, ok := query.Function(ctx).(dropped); ok {
+				return true
 			}
 		}
 	}
-	return td, nil
+	return false
 }
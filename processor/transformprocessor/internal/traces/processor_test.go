@@ -23,9 +23,15 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 var (
+	traceID = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	spanID  = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	spanID2 = [8]byte{8, 7, 6, 5, 4, 3, 2, 1}
+
 	TestSpanStartTime      = time.Date(2020, 2, 11, 20, 26, 12, 321, time.UTC)
 	TestSpanStartTimestamp = pcommon.NewTimestampFromTime(TestSpanStartTime)
 
@@ -107,6 +113,38 @@ func TestProcess(t *testing.T) {
 				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().InsertString("test", "pass")
 			},
 		},
+		{
+			query: `set(attributes["test"], "pass") where name == "operationA" or dropped_links_count > 2`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().InsertString("test", "pass")
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().InsertString("test", "pass")
+			},
+		},
+		{
+			query: `set(attributes["test"], "pass") where dropped_links_count >= 1 and not name == "operationA"`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().InsertString("test", "pass")
+			},
+		},
+		{
+			query: `set(attributes["test"], "pass") where (name == "operationA" or name == "operationB") and dropped_events_count < 1`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().InsertString("test", "pass")
+			},
+		},
+		{
+			query: `set(attributes["duration_ms"], (end_time_unix_nano - start_time_unix_nano) / 1000000)`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().InsertInt("duration_ms", 1000)
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().InsertInt("duration_ms", 1000)
+			},
+		},
+		{
+			query: `set(attributes["test"], "pass") where end_time_unix_nano - start_time_unix_nano >= 1000000000 and name == "operationA"`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().InsertString("test", "pass")
+			},
+		},
 		{
 			query: `replace_pattern(attributes["http.method"], "get", "post")`,
 			want: func(td ptrace.Traces) {
@@ -149,12 +187,138 @@ func TestProcess(t *testing.T) {
 				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().InsertString("http.url", "http://localhost/health")
 			},
 		},
+		{
+			query: `drop() where name == "operationA"`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().RemoveIf(func(span ptrace.Span) bool {
+					return span.Name() == "operationA"
+				})
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.query, func(t *testing.T) {
 			td := constructTraces()
-			processor, err := NewProcessor([]string{tt.query}, DefaultFunctions(), component.ProcessorCreateSettings{})
+			processor, err := NewProcessor([]string{tt.query}, nil, DefaultFunctions(), component.ProcessorCreateSettings{})
+			assert.NoError(t, err)
+
+			_, err = processor.ProcessTraces(context.Background(), td)
+			assert.NoError(t, err)
+
+			exTd := constructTraces()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
+func TestProcess_Contexts(t *testing.T) {
+	tests := []struct {
+		name     string
+		queries  []string
+		contexts []common.ContextQueries
+		want     func(td ptrace.Traces)
+	}{
+		{
+			name: "set resource attribute",
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ResourceContext,
+					Queries: []string{`set(attributes["env"], "prod") where attributes["host.name"] == "localhost"`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).Resource().Attributes().InsertString("env", "prod")
+			},
+		},
+		{
+			name: "set scope name",
+			contexts: []common.ContextQueries{
+				{
+					Context: common.ScopeContext,
+					Queries: []string{`set(name, "scope")`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Scope().SetName("scope")
+			},
+		},
+		{
+			name: "redact span event attribute",
+			contexts: []common.ContextQueries{
+				{
+					Context: SpanEventContext,
+					Queries: []string{`set(attributes["exception.stacktrace"], "redacted") where name == "exception"`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Events().At(0).Attributes().UpdateString("exception.stacktrace", "redacted")
+			},
+		},
+		{
+			name: "drop span event",
+			contexts: []common.ContextQueries{
+				{
+					Context: SpanEventContext,
+					Queries: []string{`drop() where name == "log" and span.name == "operationA"`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Events().RemoveIf(func(event ptrace.SpanEvent) bool {
+					return event.Name() == "log"
+				})
+			},
+		},
+		{
+			name: "set parent span from span event",
+			contexts: []common.ContextQueries{
+				{
+					Context: SpanEventContext,
+					Queries: []string{`set(span.attributes["exception"], true) where name == "exception"`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().InsertBool("exception", true)
+			},
+		},
+		{
+			name: "drop span link",
+			contexts: []common.ContextQueries{
+				{
+					Context: SpanLinkContext,
+					Queries: []string{`drop() where trace_id.string == "0102030405060708090a0b0c0d0e0f10"`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Links().RemoveIf(func(link ptrace.SpanLink) bool {
+					return !link.TraceID().IsEmpty()
+				})
+			},
+		},
+		{
+			name:    "contexts run after queries",
+			queries: []string{`set(attributes["test"], "pass") where name == "operationA"`},
+			contexts: []common.ContextQueries{
+				{
+					Context: SpanEventContext,
+					Queries: []string{`set(attributes["test"], span.attributes["test"])`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+				span.Attributes().InsertString("test", "pass")
+				span.Events().At(0).Attributes().InsertString("test", "pass")
+				span.Events().At(1).Attributes().InsertString("test", "pass")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := constructTraces()
+			processor, err := NewProcessor(tt.queries, tt.contexts, DefaultFunctions(), component.ProcessorCreateSettings{})
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessTraces(context.Background(), td)
@@ -168,6 +332,11 @@ func TestProcess(t *testing.T) {
 	}
 }
 
+func TestNewProcessor_UnknownContext(t *testing.T) {
+	_, err := NewProcessor(nil, []common.ContextQueries{{Context: "unknown", Queries: []string{`drop()`}}}, DefaultFunctions(), component.ProcessorCreateSettings{})
+	assert.Error(t, err)
+}
+
 func BenchmarkTwoSpans(b *testing.B) {
 	tests := []struct {
 		name    string
@@ -204,7 +373,7 @@ func BenchmarkTwoSpans(b *testing.B) {
 
 	for _, tt := range tests {
 		b.Run(tt.name, func(b *testing.B) {
-			processor, err := NewProcessor(tt.queries, DefaultFunctions(), component.ProcessorCreateSettings{})
+			processor, err := NewProcessor(tt.queries, nil, DefaultFunctions(), component.ProcessorCreateSettings{})
 			assert.NoError(b, err)
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
@@ -246,7 +415,7 @@ func BenchmarkHundredSpans(b *testing.B) {
 	}
 	for _, tt := range tests {
 		b.Run(tt.name, func(b *testing.B) {
-			processor, err := NewProcessor(tt.queries, DefaultFunctions(), component.ProcessorCreateSettings{})
+			processor, err := NewProcessor(tt.queries, nil, DefaultFunctions(), component.ProcessorCreateSettings{})
 			assert.NoError(b, err)
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
@@ -292,6 +461,14 @@ func fillSpanOne(span ptrace.Span) {
 	span.Attributes().InsertString("http.method", "get")
 	span.Attributes().InsertString("http.path", "/health")
 	span.Attributes().InsertString("http.url", "http://localhost/health")
+	event0 := span.Events().AppendEmpty()
+	event0.SetName("exception")
+	event0.SetTimestamp(TestSpanStartTimestamp)
+	event0.Attributes().InsertString("exception.type", "Error")
+	event0.Attributes().InsertString("exception.stacktrace", "at operationA")
+	event1 := span.Events().AppendEmpty()
+	event1.SetName("log")
+	event1.SetTimestamp(TestSpanEndTimestamp)
 	status := span.Status()
 	status.SetCode(ptrace.StatusCodeError)
 	status.SetMessage("status-cancelled")
@@ -305,6 +482,7 @@ func fillSpanTwo(span ptrace.Span) {
 	span.Attributes().InsertString("http.path", "/health")
 	span.Attributes().InsertString("http.url", "http://localhost/health")
 	link0 := span.Links().AppendEmpty()
+	link0.SetTraceID(pcommon.NewTraceID(traceID))
 	link0.SetDroppedAttributesCount(4)
 	link1 := span.Links().AppendEmpty()
 	link1.SetDroppedAttributesCount(4)
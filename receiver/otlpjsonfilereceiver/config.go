@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"go.opentelemetry.io/collector/config"
+	"gopkg.in/yaml.v2"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+)
+
+// Config defines configuration for the OTLP JSON file receiver.
+type Config struct {
+	config.ReceiverSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	// Input holds the settings of the file consumer, which are decoded by decodeInputConfig
+	Input adapter.InputConfig `mapstructure:",remain"`
+}
+
+var _ config.Receiver = (*Config)(nil)
+
+// decodeInputConfig unmarshals the file consumer settings on top of the default ones.
+// This is needed only until stanza operators are migrated to mapstructure.
+func (cfg *Config) decodeInputConfig() (*fileconsumer.Config, error) {
+	yamlBytes, _ := yaml.Marshal(cfg.Input)
+	inputCfg := fileconsumer.NewConfig()
+	if err := yaml.Unmarshal(yamlBytes, inputCfg); err != nil {
+		return nil, err
+	}
+	return inputCfg, nil
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjsonfilereceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/service/servicetest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, len(cfg.Receivers), 2)
+
+	r0 := cfg.Receivers[config.NewComponentID(typeStr)]
+	assert.Equal(t, createDefaultConfig(), r0)
+
+	r1 := cfg.Receivers[config.NewComponentIDWithName(typeStr, "all_settings")].(*Config)
+	assert.Equal(t, config.NewReceiverSettings(config.NewComponentIDWithName(typeStr, "all_settings")), r1.ReceiverSettings)
+
+	inputCfg, err := r1.decodeInputConfig()
+	require.NoError(t, err)
+	expected := fileconsumer.NewConfig()
+	expected.Include = []string{"/var/log/telemetry/*.json"}
+	expected.Exclude = []string{"/var/log/telemetry/ignored.json"}
+	expected.StartAt = "beginning"
+	expected.PollInterval = helper.NewDuration(5 * time.Second)
+	expected.MaxLogSize = 4 * 1024 * 1024
+	assert.Equal(t, expected, inputCfg)
+}
+
+func TestDecodeInvalidInputConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Input = map[string]interface{}{"include": map[string]interface{}{"path": "*.json"}}
+
+	_, err := cfg.decodeInputConfig()
+	assert.Error(t, err)
+}
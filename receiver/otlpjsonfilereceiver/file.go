@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+)
+
+const (
+	transport  = "file"
+	dataFormat = "json"
+)
+
+var (
+	tracesUnmarshaler  = ptrace.NewJSONUnmarshaler()
+	metricsUnmarshaler = pmetric.NewJSONUnmarshaler()
+	logsUnmarshaler    = plog.NewJSONUnmarshaler()
+)
+
+// requestSignals maps the first field of an OTLP JSON request to its signal
+var requestSignals = map[string]config.DataType{
+	"resourceSpans":    config.TracesDataType,
+	"resource_spans":   config.TracesDataType,
+	"resourceMetrics":  config.MetricsDataType,
+	"resource_metrics": config.MetricsDataType,
+	"resourceLogs":     config.LogsDataType,
+	"resource_logs":    config.LogsDataType,
+}
+
+// otlpJSONFileReceiver reads the files matching its configuration, passing each of their lines as an OTLP JSON
+// request of its signal to the consume function.
+type otlpJSONFileReceiver struct {
+	id       config.ComponentID
+	dataType config.DataType
+	logger   *zap.Logger
+	input    *fileconsumer.Input
+	consume  func(ctx context.Context, token []byte) error
+
+	storageClient storage.Client
+}
+
+func newTracesReceiver(cfg *Config, settings component.ReceiverCreateSettings, nextConsumer consumer.Traces) (*otlpJSONFileReceiver, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+
+	obsrecv := newObsReceiver(cfg, settings)
+	return newReceiver(cfg, settings, config.TracesDataType, func(ctx context.Context, token []byte) error {
+		ctx = obsrecv.StartTracesOp(ctx)
+		td, err := tracesUnmarshaler.UnmarshalTraces(token)
+		spanCount := 0
+		if err == nil {
+			spanCount = td.SpanCount()
+			err = nextConsumer.ConsumeTraces(ctx, td)
+		}
+		obsrecv.EndTracesOp(ctx, dataFormat, spanCount, err)
+		return err
+	})
+}
+
+func newMetricsReceiver(cfg *Config, settings component.ReceiverCreateSettings, nextConsumer consumer.Metrics) (*otlpJSONFileReceiver, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+
+	obsrecv := newObsReceiver(cfg, settings)
+	return newReceiver(cfg, settings, config.MetricsDataType, func(ctx context.Context, token []byte) error {
+		ctx = obsrecv.StartMetricsOp(ctx)
+		md, err := metricsUnmarshaler.UnmarshalMetrics(token)
+		dataPointCount := 0
+		if err == nil {
+			dataPointCount = md.DataPointCount()
+			err = nextConsumer.ConsumeMetrics(ctx, md)
+		}
+		obsrecv.EndMetricsOp(ctx, dataFormat, dataPointCount, err)
+		return err
+	})
+}
+
+func newLogsReceiver(cfg *Config, settings component.ReceiverCreateSettings, nextConsumer consumer.Logs) (*otlpJSONFileReceiver, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+
+	obsrecv := newObsReceiver(cfg, settings)
+	return newReceiver(cfg, settings, config.LogsDataType, func(ctx context.Context, token []byte) error {
+		ctx = obsrecv.StartLogsOp(ctx)
+		ld, err := logsUnmarshaler.UnmarshalLogs(token)
+		logRecordCount := 0
+		if err == nil {
+			logRecordCount = ld.LogRecordCount()
+			err = nextConsumer.ConsumeLogs(ctx, ld)
+		}
+		obsrecv.EndLogsOp(ctx, dataFormat, logRecordCount, err)
+		return err
+	})
+}
+
+func newObsReceiver(cfg *Config, settings component.ReceiverCreateSettings) *obsreport.Receiver {
+	return obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             cfg.ID(),
+		Transport:              transport,
+		ReceiverCreateSettings: settings,
+	})
+}
+
+func newReceiver(cfg *Config, settings component.ReceiverCreateSettings, dataType config.DataType, consume func(context.Context, []byte) error) (*otlpJSONFileReceiver, error) {
+	r := &otlpJSONFileReceiver{
+		id:       cfg.ID(),
+		dataType: dataType,
+		logger:   settings.Logger,
+		consume:  consume,
+	}
+
+	inputCfg, err := cfg.decodeInputConfig()
+	if err != nil {
+		return nil, err
+	}
+	input, err := inputCfg.Build(settings.Logger.Sugar(), r.emit)
+	if err != nil {
+		return nil, err
+	}
+	r.input = input
+	return r, nil
+}
+
+func (r *otlpJSONFileReceiver) Start(ctx context.Context, host component.Host) error {
+	client, err := getStorageClient(ctx, r.id, r.dataType, host)
+	if err != nil {
+		return err
+	}
+	r.storageClient = client
+
+	return r.input.Start(adapter.GetPersister(client))
+}
+
+func (r *otlpJSONFileReceiver) Shutdown(ctx context.Context) error {
+	if r.storageClient == nil {
+		return nil
+	}
+	return multierr.Combine(r.input.Stop(), r.storageClient.Close(ctx))
+}
+
+func (r *otlpJSONFileReceiver) emit(ctx context.Context, _ *fileconsumer.FileAttributes, token []byte) {
+	if len(bytes.TrimSpace(token)) == 0 {
+		return
+	}
+
+	// files written by a file exporter used in several pipelines hold requests of all the signals,
+	// so the requests of other signals are skipped instead of being reported as invalid
+	if dataType, ok := requestSignals[firstField(token)]; ok && dataType != r.dataType {
+		return
+	}
+
+	if err := r.consume(ctx, token); err != nil {
+		r.logger.Error("failed to consume OTLP JSON request", zap.String("data_type", string(r.dataType)), zap.Error(err))
+	}
+}
+
+// firstField returns the name of the first field of the given JSON object, or an empty string if it isn't one
+func firstField(token []byte) string {
+	dec := json.NewDecoder(bytes.NewReader(token))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return ""
+	}
+	t, err := dec.Token()
+	if err != nil {
+		return ""
+	}
+	name, _ := t.(string)
+	return name
+}
+
+// getStorageClient returns a client of the storage extension, if any, named after the signal of the receiver, so that
+// the receivers created for each signal of the same configuration keep track of the files they read separately.
+func getStorageClient(ctx context.Context, id config.ComponentID, dataType config.DataType, host component.Host) (storage.Client, error) {
+	var storageExtension storage.Extension
+	for _, ext := range host.GetExtensions() {
+		if se, ok := ext.(storage.Extension); ok {
+			if storageExtension != nil {
+				return nil, errors.New("multiple storage extensions found")
+			}
+			storageExtension = se
+		}
+	}
+
+	if storageExtension == nil {
+		return storage.NewNopClient(), nil
+	}
+	return storageExtension.GetClient(ctx, component.KindReceiver, id, string(dataType))
+}
@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjsonfilereceiver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+)
+
+func TestReadAllSignals(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	writeLines(t, filepath.Join(dir, "telemetry.json"),
+		marshalTraces(t, "span-1"),
+		marshalMetrics(t, "metric-1"),
+		marshalLogs(t, "log-1"),
+		"",
+		marshalTraces(t, "span-2"),
+	)
+	cfg := testConfig(dir)
+
+	tracesSink := new(consumertest.TracesSink)
+	tr, err := newTracesReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), tracesSink)
+	require.NoError(t, err)
+	metricsSink := new(consumertest.MetricsSink)
+	mr, err := newMetricsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), metricsSink)
+	require.NoError(t, err)
+	logsSink := new(consumertest.LogsSink)
+	lr, err := newLogsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), logsSink)
+	require.NoError(t, err)
+
+	// test
+	for _, r := range []component.Component{tr, mr, lr} {
+		require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+		defer func(r component.Component) {
+			assert.NoError(t, r.Shutdown(context.Background()))
+		}(r)
+	}
+
+	// verify
+	require.Eventually(t, func() bool {
+		return tracesSink.SpanCount() == 2 && metricsSink.DataPointCount() == 1 && logsSink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	traces := tracesSink.AllTraces()
+	require.Len(t, traces, 2)
+	assert.Equal(t, "span-1", traces[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, "span-2", traces[1].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, "metric-1", metricsSink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+	assert.Equal(t, "log-1", logsSink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().StringVal())
+}
+
+func TestSkipInvalidLines(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	writeLines(t, filepath.Join(dir, "telemetry.json"),
+		"not json",
+		`{"resourceSpans": "invalid"}`,
+		`{"resourceSpans": [`,
+		`{"resourceMetrics": [`,
+		`{"resourceLogs": [`,
+		marshalTraces(t, "span-1"),
+		marshalMetrics(t, "metric-1"),
+		marshalLogs(t, "log-1"),
+	)
+	settings := componenttest.NewNopReceiverCreateSettings()
+	tracesSink := new(consumertest.TracesSink)
+	tr, err := newTracesReceiver(testConfig(dir), settings, tracesSink)
+	require.NoError(t, err)
+	metricsSink := new(consumertest.MetricsSink)
+	mr, err := newMetricsReceiver(testConfig(dir), settings, metricsSink)
+	require.NoError(t, err)
+	logsSink := new(consumertest.LogsSink)
+	lr, err := newLogsReceiver(testConfig(dir), settings, logsSink)
+	require.NoError(t, err)
+
+	// test
+	for _, r := range []component.Component{tr, mr, lr} {
+		require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+		defer func(r component.Component) {
+			assert.NoError(t, r.Shutdown(context.Background()))
+		}(r)
+	}
+
+	// verify
+	require.Eventually(t, func() bool {
+		return tracesSink.SpanCount() == 1 && metricsSink.DataPointCount() == 1 && logsSink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestResumeFromCheckpoint(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	storageDir := t.TempDir()
+	path := filepath.Join(dir, "telemetry.json")
+	writeLines(t, path, marshalLogs(t, "log-1"), marshalLogs(t, "log-2"))
+	cfg := testConfig(dir)
+
+	sink := new(consumertest.LogsSink)
+	r, err := newLogsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), sink)
+	require.NoError(t, err)
+	host := storagetest.NewStorageHost(t, storageDir, "test")
+	require.NoError(t, r.Start(context.Background(), host))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	// test
+	writeLines(t, path, marshalLogs(t, "log-3"))
+	sink.Reset()
+	r, err = newLogsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), sink)
+	require.NoError(t, err)
+	host = storagetest.NewStorageHost(t, storageDir, "test")
+	require.NoError(t, r.Start(context.Background(), host))
+	defer func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	// verify
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "log-3", sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().StringVal())
+}
+
+func TestFirstField(t *testing.T) {
+	for _, tt := range []struct {
+		token string
+		want  string
+	}{
+		{`{"resourceSpans":[]}`, "resourceSpans"},
+		{` { "resource_logs" : [] } `, "resource_logs"},
+		{`{}`, ""},
+		{`[1, 2]`, ""},
+		{`not json`, ""},
+	} {
+		t.Run(tt.token, func(t *testing.T) {
+			assert.Equal(t, tt.want, firstField([]byte(tt.token)))
+		})
+	}
+}
+
+func testConfig(dir string) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Input = adapter.InputConfig{
+		"include":       []string{filepath.Join(dir, "*.json")},
+		"start_at":      "beginning",
+		"poll_interval": "10ms",
+	}
+	return cfg
+}
+
+func writeLines(t *testing.T, path string, lines ...string) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	defer f.Close()
+	for _, line := range lines {
+		_, err = f.WriteString(line + "\n")
+		require.NoError(t, err)
+	}
+}
+
+func marshalTraces(t *testing.T, spanName string) string {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(spanName)
+	buf, err := ptrace.NewJSONMarshaler().MarshalTraces(td)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func marshalMetrics(t *testing.T, metricName string) string {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(metricName)
+	m.SetDataType(pmetric.MetricDataTypeGauge)
+	m.Gauge().DataPoints().AppendEmpty().SetIntVal(1)
+	buf, err := pmetric.NewJSONMarshaler().MarshalMetrics(md)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func marshalLogs(t *testing.T, body string) string {
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal(body)
+	buf, err := plog.NewJSONMarshaler().MarshalLogs(ld)
+	require.NoError(t, err)
+	return string(buf)
+}
@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewritereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusremotewritereceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+)
+
+const (
+	dataFormat      = "protobuf"
+	snappyEncoding  = "snappy"
+	contentEncoding = "Content-Encoding"
+
+	// maxCompressedSize is the maximum size of the body of a write request
+	maxCompressedSize = 32 << 20
+	// maxDecodedSize is the maximum size of a write request once decompressed
+	maxDecodedSize = 128 << 20
+)
+
+var (
+	errNilNextConsumer = errors.New("nil nextConsumer")
+	errTooLarge        = errors.New("request too large")
+)
+
+type metricsReceiver struct {
+	config       *Config
+	settings     component.ReceiverCreateSettings
+	nextConsumer consumer.Metrics
+	obsrecv      *obsreport.Receiver
+
+	server *http.Server
+	wg     sync.WaitGroup
+}
+
+func newMetricsReceiver(config *Config, settings component.ReceiverCreateSettings, nextConsumer consumer.Metrics) (*metricsReceiver, error) {
+	if nextConsumer == nil {
+		return nil, errNilNextConsumer
+	}
+
+	transport := "http"
+	if config.TLSSetting != nil {
+		transport = "https"
+	}
+
+	return &metricsReceiver{
+		config:       config,
+		settings:     settings,
+		nextConsumer: nextConsumer,
+		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             config.ID(),
+			Transport:              transport,
+			ReceiverCreateSettings: settings,
+		}),
+	}, nil
+}
+
+func (r *metricsReceiver) Start(_ context.Context, host component.Host) error {
+	ln, err := r.config.HTTPServerSettings.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", r.config.Endpoint, err)
+	}
+
+	router := http.NewServeMux()
+	router.HandleFunc(r.config.Path, r.handleWrite)
+
+	r.server, err = r.config.HTTPServerSettings.ToServer(host, r.settings.TelemetrySettings, router)
+	if err != nil {
+		return err
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if errHTTP := r.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+
+	return nil
+}
+
+func (r *metricsReceiver) Shutdown(context.Context) error {
+	if r.server == nil {
+		return nil
+	}
+	err := r.server.Close()
+	r.wg.Wait()
+	return err
+}
+
+func (r *metricsReceiver) handleWrite(w http.ResponseWriter, req *http.Request) {
+	defer func() {
+		_ = req.Body.Close()
+	}()
+
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if encoding := req.Header.Get(contentEncoding); encoding != "" && encoding != snappyEncoding {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		_, _ = fmt.Fprintf(w, "unsupported content encoding, only %q is supported", snappyEncoding)
+		return
+	}
+
+	ctx := r.obsrecv.StartMetricsOp(req.Context())
+
+	writeRequest, err := decodeWriteRequest(http.MaxBytesReader(w, req.Body, maxCompressedSize))
+	if err != nil {
+		r.obsrecv.EndMetricsOp(ctx, dataFormat, 0, err)
+		if errors.Is(err, errTooLarge) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = fmt.Fprintf(w, "failed to decode the write request: %s", err.Error())
+		return
+	}
+
+	// the series that can't be translated are reported to the client once the others were consumed
+	md, translateErr := prometheusremotewrite.ToMetrics(writeRequest)
+	numPoints := md.DataPointCount()
+	if numPoints > 0 {
+		err = r.nextConsumer.ConsumeMetrics(ctx, md)
+	}
+	r.obsrecv.EndMetricsOp(ctx, dataFormat, numPoints, err)
+
+	if err != nil {
+		r.settings.Logger.Debug("failed to pass metrics to next consumer", zap.Error(err))
+		if consumererror.IsPermanent(err) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		_, _ = fmt.Fprint(w, err.Error())
+		return
+	}
+
+	if translateErr != nil {
+		r.settings.Logger.Debug("failed to translate some of the time series", zap.Error(translateErr))
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, "failed to translate some of the time series: %s", translateErr.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeWriteRequest reads a snappy compressed remote write request from a body
+// limited to maxCompressedSize bytes
+func decodeWriteRequest(body io.Reader) (*prompb.WriteRequest, error) {
+	compressed, err := io.ReadAll(body)
+	if err != nil {
+		// the body is only cut short by its size limit once all the allowed bytes were read
+		if len(compressed) >= maxCompressedSize {
+			return nil, fmt.Errorf("%w: the body exceeds %d bytes", errTooLarge, maxCompressedSize)
+		}
+		return nil, err
+	}
+
+	// the decoded length is read from the header, so that oversized requests are rejected before allocating them
+	decodedLen, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, err
+	}
+	if decodedLen > maxDecodedSize {
+		return nil, fmt.Errorf("%w: the decompressed body exceeds %d bytes", errTooLarge, maxDecodedSize)
+	}
+
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, err
+	}
+
+	writeRequest := &prompb.WriteRequest{}
+	if err = writeRequest.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return writeRequest, nil
+}
@@ -0,0 +1,246 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewritereceiver
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestWriteRequest(t *testing.T) {
+	// prepare
+	sink := new(consumertest.MetricsSink)
+	url := startReceiver(t, sink)
+
+	// test
+	resp := post(t, url, snappyEncoding, encode(t, &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{
+					{Name: "__name__", Value: "http_requests_total"},
+					{Name: "job", Value: "api"},
+					{Name: "code", Value: "200"},
+				},
+				Samples: []prompb.Sample{{Value: 42, Timestamp: time.Now().UnixMilli()}},
+			},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total"},
+		},
+	}))
+
+	// verify
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Len(t, sink.AllMetrics(), 1)
+
+	md := sink.AllMetrics()[0]
+	require.Equal(t, 1, md.MetricCount())
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "http_requests_total", m.Name())
+	assert.Equal(t, pmetric.MetricDataTypeSum, m.DataType())
+	assert.Equal(t, 42.0, m.Sum().DataPoints().At(0).DoubleVal())
+}
+
+func TestInvalidRequests(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	url := startReceiver(t, sink)
+
+	for _, tt := range []struct {
+		desc     string
+		method   string
+		encoding string
+		body     []byte
+		want     int
+	}{
+		{
+			desc:     "wrong method",
+			method:   http.MethodGet,
+			encoding: snappyEncoding,
+			want:     http.StatusMethodNotAllowed,
+		},
+		{
+			desc:     "wrong encoding",
+			method:   http.MethodPost,
+			encoding: "br",
+			body:     []byte("data"),
+			want:     http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:     "not compressed",
+			method:   http.MethodPost,
+			encoding: snappyEncoding,
+			body:     []byte("data"),
+			want:     http.StatusBadRequest,
+		},
+		{
+			desc:     "not a write request",
+			method:   http.MethodPost,
+			encoding: snappyEncoding,
+			body:     snappy.Encode(nil, []byte("data")),
+			want:     http.StatusBadRequest,
+		},
+		{
+			desc:     "body too large",
+			method:   http.MethodPost,
+			encoding: snappyEncoding,
+			body:     make([]byte, maxCompressedSize+1),
+			want:     http.StatusRequestEntityTooLarge,
+		},
+		{
+			desc:     "decompressed body too large",
+			method:   http.MethodPost,
+			encoding: snappyEncoding,
+			body:     snappyHeader(maxDecodedSize + 1),
+			want:     http.StatusRequestEntityTooLarge,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// prepare
+			req, err := http.NewRequest(tt.method, url, bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set(contentEncoding, tt.encoding)
+
+			// test
+			resp, err := http.DefaultClient.Do(req)
+
+			// verify
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+
+	assert.Len(t, sink.AllMetrics(), 0)
+}
+
+func TestPartiallyInvalidWriteRequest(t *testing.T) {
+	// prepare
+	sink := new(consumertest.MetricsSink)
+	url := startReceiver(t, sink)
+
+	// test
+	resp := post(t, url, snappyEncoding, encode(t, &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  []prompb.Label{{Name: "job", Value: "api"}},
+				Samples: []prompb.Sample{{Value: 1, Timestamp: time.Now().UnixMilli()}},
+			},
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "temperature"}},
+				Samples: []prompb.Sample{{Value: 21, Timestamp: time.Now().UnixMilli()}},
+			},
+		},
+	}))
+
+	// verify
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, 1, sink.AllMetrics()[0].MetricCount())
+}
+
+func TestConsumerErrors(t *testing.T) {
+	for _, tt := range []struct {
+		desc string
+		err  error
+		want int
+	}{
+		{
+			desc: "retryable error",
+			err:  fmt.Errorf("temporary failure"),
+			want: http.StatusInternalServerError,
+		},
+		{
+			desc: "permanent error",
+			err:  consumererror.NewPermanent(fmt.Errorf("permanent failure")),
+			want: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// prepare
+			url := startReceiver(t, consumertest.NewErr(tt.err))
+
+			// test
+			resp := post(t, url, snappyEncoding, encode(t, &prompb.WriteRequest{
+				Timeseries: []prompb.TimeSeries{
+					{
+						Labels:  []prompb.Label{{Name: "__name__", Value: "temperature"}},
+						Samples: []prompb.Sample{{Value: 21, Timestamp: time.Now().UnixMilli()}},
+					},
+				},
+			}))
+
+			// verify
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func startReceiver(t *testing.T, next consumer.Metrics) string {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+
+	r, err := newMetricsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), next)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+	})
+
+	return fmt.Sprintf("http://%s%s", cfg.Endpoint, cfg.Path)
+}
+
+func encode(t *testing.T, req *prompb.WriteRequest) []byte {
+	data, err := req.Marshal()
+	require.NoError(t, err)
+	return snappy.Encode(nil, data)
+}
+
+func post(t *testing.T, url string, encoding string, body []byte) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set(contentEncoding, encoding)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_, _ = io.Copy(io.Discard, resp.Body)
+	require.NoError(t, resp.Body.Close())
+	return resp
+}
+
+// snappyHeader returns a snappy block which only claims to decode to length bytes
+func snappyHeader(length uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, length)
+	return append(buf[:n], "data"...)
+}